@@ -0,0 +1,233 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 6:10
+ */
+
+package domain
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+const (
+	AlarmEventStatusChanged     = "status_changed"
+	AlarmEventRestart           = "restart"
+	AlarmEventRestartGaveUp     = "restart_gave_up"
+	AlarmEventLeftoverProcess   = "leftover_process"
+	AlarmEventThresholdExceeded = "threshold_exceeded"
+	AlarmEventThresholdRecover  = "threshold_recovered"
+	AlarmEventFlapping          = "flapping"
+	AlarmEventFlapRecovered     = "flap_recovered"
+	AlarmEventDumpFailed        = "dump_failed"
+	AlarmEventTest              = "test"
+)
+
+// Alarm juno 가 발생시키는 알람. sink 의 template 에서 필드를 참조할 수 있다
+type Alarm struct {
+	Time       int64  `json:"time"`  // unix millis
+	Level      string `json:"level"` // WARN, MINOR, MAJOR
+	Category   string `json:"category"`
+	Event      string `json:"event"`
+	Process    string `json:"process,omitempty"`
+	Message    string `json:"message"`
+	Package    string `json:"package"`
+	Host       string `json:"host"`
+	Suppressed int    `json:"suppressed,omitempty"` // rate limit 으로 직전에 생략된 알람 수
+}
+
+const (
+	AlarmSinkNotify  = "notify"  // 기존 SystemNotifyHandler
+	AlarmSinkWebhook = "webhook" // JSON POST
+	AlarmSinkFile    = "file"    // 로컬 파일 (한 줄에 하나)
+	AlarmSinkSyslog  = "syslog"
+	AlarmSinkCommand = "command" // stdin 으로 알람 JSON 을 전달하여 실행
+)
+
+// AlarmSinkConfig 알람을 전달할 대상과 routing 조건
+type AlarmSinkConfig struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+
+	// routing. 비어있으면 모두 허용. processes 는 glob 패턴
+	Levels     []string `json:"levels,omitempty"`
+	Categories []string `json:"categories,omitempty"`
+	Processes  []string `json:"processes,omitempty"`
+
+	// RatePeriodSec 동안 최대 RateLimit 건만 전달한다. 0 이면 제한 없음
+	RateLimit     int `json:"rate_limit,omitempty"`
+	RatePeriodSec int `json:"rate_period_sec,omitempty"`
+
+	// text/template. 비어있으면 기본 메시지 (webhook, file, command 는 알람 JSON)
+	Template string `json:"template,omitempty"`
+
+	Url        string            `json:"url,omitempty"` // webhook
+	Headers    map[string]string `json:"headers,omitempty"`
+	Path       string            `json:"path,omitempty"`    // file. $FATIMA_HOME/data/alarm/sink 아래
+	Tag        string            `json:"tag,omitempty"`     // syslog
+	Command    string            `json:"command,omitempty"` // command. $FATIMA_HOME/app/juno/alarm 의 실행 파일
+	Args       []string          `json:"args,omitempty"`
+	TimeoutSec int               `json:"timeout_sec,omitempty"` // webhook, command
+}
+
+func (c AlarmSinkConfig) Validate() error {
+	if len(c.Name) == 0 {
+		return fmt.Errorf("empty alarm sink name")
+	}
+	switch c.Type {
+	case AlarmSinkNotify, AlarmSinkSyslog:
+	case AlarmSinkWebhook:
+		u, err := url.Parse(c.Url)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || len(u.Host) == 0 {
+			return fmt.Errorf("[%s] invalid webhook url : %s", c.Name, c.Url)
+		}
+	case AlarmSinkFile:
+		if !filepath.IsAbs(c.Path) {
+			return fmt.Errorf("[%s] file path must be absolute : %s", c.Name, c.Path)
+		}
+	case AlarmSinkCommand:
+		if !filepath.IsAbs(c.Command) {
+			return fmt.Errorf("[%s] command must be absolute path : %s", c.Name, c.Command)
+		}
+	default:
+		return fmt.Errorf("[%s] invalid alarm sink type : %s", c.Name, c.Type)
+	}
+
+	for _, level := range c.Levels {
+		switch strings.ToUpper(level) {
+		case "WARN", "MINOR", "MAJOR":
+		default:
+			return fmt.Errorf("[%s] invalid alarm level : %s", c.Name, level)
+		}
+	}
+	for _, pattern := range c.Processes {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("[%s] invalid process pattern %s : %s", c.Name, pattern, err.Error())
+		}
+	}
+	if c.RateLimit < 0 || (c.RateLimit > 0 && c.RatePeriodSec <= 0) {
+		return fmt.Errorf("[%s] invalid rate limit : %d/%dsec", c.Name, c.RateLimit, c.RatePeriodSec)
+	}
+	if c.TimeoutSec < 0 {
+		return fmt.Errorf("[%s] invalid timeout : %d", c.Name, c.TimeoutSec)
+	}
+	if len(c.Template) > 0 {
+		if _, err := template.New(c.Name).Parse(c.Template); err != nil {
+			return fmt.Errorf("[%s] invalid template : %s", c.Name, err.Error())
+		}
+	}
+	return nil
+}
+
+// Match 알람이 sink 의 routing 조건에 맞는지 여부
+func (c AlarmSinkConfig) Match(alarm Alarm) bool {
+	if len(c.Levels) > 0 && !containsFold(c.Levels, alarm.Level) {
+		return false
+	}
+	if len(c.Categories) > 0 && !containsFold(c.Categories, alarm.Category) {
+		return false
+	}
+	if len(c.Processes) > 0 {
+		for _, pattern := range c.Processes {
+			if ok, _ := path.Match(pattern, alarm.Process); ok {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+func containsFold(list []string, v string) bool {
+	for _, item := range list {
+		if strings.EqualFold(item, v) {
+			return true
+		}
+	}
+	return false
+}
+
+// AlarmConfig 알람 sink 목록. 알람은 조건에 맞는 모든 sink 로 전달된다
+type AlarmConfig struct {
+	Sinks []AlarmSinkConfig `json:"sinks"`
+}
+
+// AlarmHeaderMask 설정 조회 시 webhook header 값(인증 토큰 등)을 대신한다
+const AlarmHeaderMask = "******"
+
+// Masked webhook header 값을 가린 사본
+func (c AlarmConfig) Masked() AlarmConfig {
+	masked := AlarmConfig{Sinks: make([]AlarmSinkConfig, len(c.Sinks))}
+	for i, sink := range c.Sinks {
+		if len(sink.Headers) > 0 {
+			headers := make(map[string]string, len(sink.Headers))
+			for k := range sink.Headers {
+				headers[k] = AlarmHeaderMask
+			}
+			sink.Headers = headers
+		}
+		masked.Sinks[i] = sink
+	}
+	return masked
+}
+
+// RestoreMasked 가려진 header 값을 같은 이름의 sink 의 현재 값으로 되돌린다
+func (c *AlarmConfig) RestoreMasked(current AlarmConfig) {
+	for i, sink := range c.Sinks {
+		for k, v := range sink.Headers {
+			if v != AlarmHeaderMask {
+				continue
+			}
+			for _, prev := range current.Sinks {
+				if prev.Name == sink.Name {
+					if value, ok := prev.Headers[k]; ok {
+						c.Sinks[i].Headers[k] = value
+					}
+				}
+			}
+		}
+	}
+}
+
+func (c AlarmConfig) Validate() error {
+	names := make(map[string]bool)
+	for _, sink := range c.Sinks {
+		if err := sink.Validate(); err != nil {
+			return err
+		}
+		if names[sink.Name] {
+			return fmt.Errorf("duplicated alarm sink name : %s", sink.Name)
+		}
+		names[sink.Name] = true
+	}
+	return nil
+}
+
+// AlarmSinkStatus sink 별 전달 통계
+type AlarmSinkStatus struct {
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Sent        int64  `json:"sent"`
+	Failed      int64  `json:"failed"`
+	RateLimited int64  `json:"rate_limited"`
+	LastError   string `json:"last_error,omitempty"`
+}
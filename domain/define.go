@@ -33,8 +33,10 @@ const (
 const (
 	HEADER_FATIMA_AUTH_TOKEN = "fatima-auth-token"
 
-	PROC_STATUS_ALIVE = "ALIVE"
-	PROC_STATUS_DEAD  = "DEAD"
+	PROC_STATUS_ALIVE  = "ALIVE"
+	PROC_STATUS_DEAD   = "DEAD"
+	PROC_STATUS_PAUSED = "PAUSED" // SIGSTOP 으로 정지된 상태. 프로세스는 살아있다
+	PROC_STATUS_HUNG   = "HUNG"   // hb 프로세스의 heartbeat 가 연속으로 누락된 상태. 프로세스는 살아있다
 
 	FOLDER_PACKAGE = "package"
 	FOLDER_CFM     = "cfm"
@@ -0,0 +1,123 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 5:50
+ */
+
+package domain
+
+import "fmt"
+
+// PackageDefinition fatima-package.yaml 의 group, process 정의. Version 은 파일 내용의 hash
+type PackageDefinition struct {
+	Version   string              `json:"version"`
+	Groups    []GroupDefinition   `json:"groups"`
+	Processes []ProcessDefinition `json:"processes"`
+}
+
+type GroupDefinition struct {
+	Id   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type ProcessDefinition struct {
+	Name      string `json:"name"`
+	Gid       int    `json:"gid"`
+	Group     string `json:"group"`
+	LogLevel  string `json:"loglevel"`
+	Hb        bool   `json:"hb"`
+	Path      string `json:"path,omitempty"`
+	Grep      string `json:"grep,omitempty"`
+	Startmode int    `json:"startmode"`
+	Weight    int    `json:"weight"`
+	StartSec  int    `json:"startsec"`
+}
+
+// ProcessUpdate 프로세스 정의 변경 요청. nil 인 항목은 변경하지 않는다
+type ProcessUpdate struct {
+	Version   string  `json:"version"`
+	Process   string  `json:"process"`
+	GroupId   string  `json:"group_id,omitempty"` // group id 혹은 group 이름
+	Hb        *bool   `json:"hb,omitempty"`
+	Path      *string `json:"path,omitempty"`
+	Grep      *string `json:"grep,omitempty"`
+	Startmode *int    `json:"startmode,omitempty"`
+	Weight    *int    `json:"weight,omitempty"`
+	StartSec  *int    `json:"startsec,omitempty"`
+	Restart   bool    `json:"restart,omitempty"` // 재기동이 필요한 변경이면 재기동까지 수행
+}
+
+func (u ProcessUpdate) Validate() error {
+	if len(u.Version) == 0 {
+		return fmt.Errorf("version is required")
+	}
+	if len(u.Process) == 0 {
+		return fmt.Errorf("process is required")
+	}
+	if u.Startmode != nil && (*u.Startmode < 0 || *u.Startmode > 3) {
+		return fmt.Errorf("invalid startmode : %d", *u.Startmode)
+	}
+	if u.Weight != nil && *u.Weight < 0 {
+		return fmt.Errorf("invalid weight : %d", *u.Weight)
+	}
+	if u.StartSec != nil && *u.StartSec < 0 {
+		return fmt.Errorf("invalid startsec : %d", *u.StartSec)
+	}
+	return nil
+}
+
+// NeedRestart 실행 중인 프로세스에 반영하려면 재기동이 필요한 변경인지 여부
+func (u ProcessUpdate) NeedRestart(prev ProcessDefinition) bool {
+	if u.Path != nil && *u.Path != prev.Path {
+		return true
+	}
+	if u.Hb != nil && *u.Hb != prev.Hb {
+		return true
+	}
+	return false
+}
+
+// GroupUpdate 그룹 이름 변경 요청. 존재하지 않는 id 이면 새로운 그룹을 추가한다
+type GroupUpdate struct {
+	Version string `json:"version"`
+	Id      int    `json:"id"`
+	Name    string `json:"name"`
+}
+
+func (u GroupUpdate) Validate() error {
+	if len(u.Version) == 0 {
+		return fmt.Errorf("version is required")
+	}
+	if u.Id < 1 {
+		return fmt.Errorf("invalid group id : %d", u.Id)
+	}
+	if !processNamePattern.MatchString(u.Name) {
+		return fmt.Errorf("invalid group name : %s", u.Name)
+	}
+	return nil
+}
+
+// DefinitionUpdateResult 정의 변경 결과
+type DefinitionUpdateResult struct {
+	Version         string             `json:"version"`
+	Backup          string             `json:"backup"`
+	Process         *ProcessDefinition `json:"process,omitempty"`
+	Group           *GroupDefinition   `json:"group,omitempty"`
+	RestartRequired bool               `json:"restart_required"`
+	Restarting      bool               `json:"restarting,omitempty"` // 재기동이 비동기로 진행중
+	Results         ProcessResults     `json:"results,omitempty"`
+}
@@ -0,0 +1,45 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 5:54
+ */
+
+package domain
+
+const (
+	DumpMethodSigquit = "sigquit"
+	DumpTypeJava      = "java"
+	DumpTypeFatima    = "fatima"
+	DumpTypeNative    = "native"
+)
+
+// DumpInfo proc 폴더에 저장된 stack dump 파일 정보
+type DumpInfo struct {
+	Process string `json:"process"`
+	Pid     int    `json:"pid"`
+	Type    string `json:"type"`   // java, fatima, native
+	Method  string `json:"method"` // sigquit
+	File    string `json:"file"`
+	Size    int64  `json:"size"`
+	Created int64  `json:"created"` // unix millis
+}
+
+// ProcessDump stack dump 내용
+type ProcessDump struct {
+	DumpInfo
+	Content string `json:"content"`
+}
@@ -0,0 +1,78 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 6:19
+ */
+
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// 프로세스 lifecycle 이벤트
+const (
+	ProcessEventStarted         = "started"
+	ProcessEventStopped         = "stopped"
+	ProcessEventCrashed         = "crashed"
+	ProcessEventRestarted       = "restarted"
+	ProcessEventHung            = "hung"
+	ProcessEventDeployActivated = "deploy_activated"
+)
+
+// 이벤트를 발생시킨 주체
+const (
+	EventActorOperator = "operator" // web api 를 통한 운영자 요청
+	EventActorMonitor  = "monitor"  // juno 프로세스 모니터
+	EventActorSchedule = "schedule" // 예약 작업
+	EventActorJuno     = "juno"     // juno 기동, HA/PS 상태 변경 등
+)
+
+func IsValidProcessEvent(event string) bool {
+	switch event {
+	case ProcessEventStarted, ProcessEventStopped, ProcessEventCrashed,
+		ProcessEventRestarted, ProcessEventHung, ProcessEventDeployActivated:
+		return true
+	}
+	return false
+}
+
+// ProcessEvent 프로세스 lifecycle 이벤트 기록
+type ProcessEvent struct {
+	Time    int64        `json:"time"` // unix millis
+	Process string       `json:"process"`
+	Event   string       `json:"event"`
+	Pid     int          `json:"pid,omitempty"`
+	Actor   string       `json:"actor"`
+	Reason  string       `json:"reason,omitempty"`
+	Exit    *ProcessExit `json:"exit,omitempty"`
+}
+
+func (e ProcessEvent) Text() string {
+	text := fmt.Sprintf("%s %s by %s", time.UnixMilli(e.Time).Format("2006-01-02 15:04:05"), e.Event, e.Actor)
+	if e.Pid > 0 {
+		text = fmt.Sprintf("%s, pid=%d", text, e.Pid)
+	}
+	if len(e.Reason) > 0 {
+		text = fmt.Sprintf("%s, %s", text, e.Reason)
+	}
+	if e.Exit != nil {
+		text = fmt.Sprintf("%s, %s", text, e.Exit.Text())
+	}
+	return text
+}
@@ -0,0 +1,67 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 6:03
+ */
+
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ProcessExit SIGCHLD reaper 가 회수한 프로세스의 종료 정보
+// juno 가 직접 기동한 자식 프로세스만 회수할 수 있다. shell 로 기동된 java 나 juno 재시작 이전에 기동된 프로세스처럼
+// juno 의 자식이 아닌 경우에는 종료 코드를 알 수 없으며 Unknown 으로 표시된다
+type ProcessExit struct {
+	Process    string `json:"process"`
+	Pid        int    `json:"pid"`
+	Time       int64  `json:"time"`             // 회수 시각 (unix millis)
+	ExitCode   int    `json:"exit_code"`        // signal 로 종료되었다면 -1
+	Signal     string `json:"signal,omitempty"` // 종료시킨 signal 이름 (예 : SIGSEGV)
+	CoreDumped bool   `json:"core_dumped,omitempty"`
+	UserCpuMs  int64  `json:"user_cpu_ms"`
+	SysCpuMs   int64  `json:"sys_cpu_ms"`
+	MaxRssKb   int64  `json:"max_rss_kb"`
+	Unknown    bool   `json:"unknown,omitempty"`
+}
+
+// IsNormal exit code 0 으로 스스로 종료되었는지 여부. 알 수 없는 경우는 정상 종료로 보지 않는다
+func (e ProcessExit) IsNormal() bool {
+	return !e.Unknown && len(e.Signal) == 0 && e.ExitCode == 0
+}
+
+// Text 알람 메시지에 첨부하기 위한 한 줄 요약
+func (e ProcessExit) Text() string {
+	if e.Unknown {
+		return fmt.Sprintf("unknown (pid=%d is not a child of juno)", e.Pid)
+	}
+
+	var b strings.Builder
+	if len(e.Signal) > 0 {
+		b.WriteString(fmt.Sprintf("killed by %s", e.Signal))
+		if e.CoreDumped {
+			b.WriteString(" (core dumped)")
+		}
+	} else {
+		b.WriteString(fmt.Sprintf("exit code %d", e.ExitCode))
+	}
+	b.WriteString(fmt.Sprintf(", pid=%d, cpu(user=%dms, sys=%dms), maxrss=%dKB",
+		e.Pid, e.UserCpuMs, e.SysCpuMs, e.MaxRssKb))
+	return b.String()
+}
@@ -0,0 +1,28 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 6:08
+ */
+
+package domain
+
+// FlapState ALIVE/DEAD 를 반복하는 중인 프로세스의 상태
+type FlapState struct {
+	Since       int64 `json:"since"`       // flapping 으로 판단한 시각 (unix millis)
+	Transitions int   `json:"transitions"` // flapping 기간 동안의 상태 변경 횟수
+	Suppressed  int   `json:"suppressed"`  // 생략된 알람 수
+}
@@ -0,0 +1,64 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 6:16
+ */
+
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// fatima IPC 에는 응답을 받을 수 있는 확인용 명령이 없어 monitor 파일만 지원한다. 연결만으로는 hung 을 판단할 수 없다
+	HeartbeatMethodMonitor = "monitor" // <proc>.<pid>.monitor 파일의 갱신 시각으로 판단
+
+	HeartbeatActionAlarm   = "alarm"
+	HeartbeatActionDump    = "dump"
+	HeartbeatActionRestart = "restart"
+)
+
+func IsValidHeartbeatMethod(method string) bool {
+	return method == HeartbeatMethodMonitor
+}
+
+func IsValidHeartbeatAction(action string) bool {
+	switch action {
+	case HeartbeatActionAlarm, HeartbeatActionDump, HeartbeatActionRestart:
+		return true
+	}
+	return false
+}
+
+// HeartbeatState hb 프로세스의 heartbeat 상태
+type HeartbeatState struct {
+	Method    string `json:"method"`
+	LastBeat  int64  `json:"last_beat,omitempty"`  // 마지막으로 확인된 heartbeat 시각 (unix millis)
+	Misses    int    `json:"misses"`               // 연속 누락 횟수
+	HungSince int64  `json:"hung_since,omitempty"` // HUNG 으로 판단한 시각 (unix millis)
+	Action    string `json:"action,omitempty"`
+}
+
+func (h HeartbeatState) Text() string {
+	last := "-"
+	if h.LastBeat > 0 {
+		last = time.UnixMilli(h.LastBeat).Format("2006-01-02 15:04:05")
+	}
+	return fmt.Sprintf("method=%s, 마지막 heartbeat=%s, 연속 누락 %d회", h.Method, last, h.Misses)
+}
@@ -0,0 +1,97 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 5:27
+ */
+
+package domain
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// LaunchSpec 프로세스 기동시 적용되는 실행 인자, 환경변수, 작업 디렉토리, umask 정보
+// fatima-package.yaml 에는 표현할 수 없으므로 juno data 폴더 하위에 프로세스별로 저장된다
+type LaunchSpec struct {
+	Args       []string          `json:"args,omitempty"`
+	Env        map[string]string `json:"env,omitempty"`
+	EnvFiles   []string          `json:"env_files,omitempty"`
+	WorkingDir string            `json:"working_dir,omitempty"`
+	Umask      string            `json:"umask,omitempty"`
+	User       string            `json:"user,omitempty"`
+	Group      string            `json:"group,omitempty"`
+	Resource   ResourceSpec      `json:"resource,omitempty"`
+}
+
+func (l LaunchSpec) IsEmpty() bool {
+	return len(l.Args) == 0 &&
+		len(l.Env) == 0 &&
+		len(l.EnvFiles) == 0 &&
+		len(l.WorkingDir) == 0 &&
+		len(l.Umask) == 0 &&
+		len(l.User) == 0 &&
+		len(l.Group) == 0 &&
+		l.Resource.IsEmpty()
+}
+
+// GetUmask umask 값을 8진수로 해석한다. 설정되지 않았다면 false 를 리턴
+func (l LaunchSpec) GetUmask() (int, bool) {
+	if len(l.Umask) == 0 {
+		return 0, false
+	}
+
+	v, err := strconv.ParseUint(l.Umask, 8, 32)
+	if err != nil {
+		return 0, false
+	}
+	return int(v), true
+}
+
+func (l LaunchSpec) Validate() error {
+	if len(l.Umask) > 0 {
+		v, err := strconv.ParseUint(l.Umask, 8, 32)
+		if err != nil || v > 0777 {
+			return fmt.Errorf("invalid umask : %s", l.Umask)
+		}
+	}
+
+	for k := range l.Env {
+		if len(k) == 0 || strings.ContainsAny(k, "= \t\n") {
+			return fmt.Errorf("invalid env name : [%s]", k)
+		}
+	}
+
+	for _, f := range l.EnvFiles {
+		if strings.Contains(f, "..") {
+			return fmt.Errorf("invalid env file : %s", f)
+		}
+	}
+
+	return l.Resource.Validate()
+}
+
+type LaunchInfo struct {
+	CommandLine string         `json:"command_line"`
+	WorkingDir  string         `json:"working_dir"`
+	Umask       string         `json:"umask,omitempty"`
+	User        string         `json:"user,omitempty"`
+	Group       string         `json:"group,omitempty"`
+	EnvKeys     []string       `json:"env_keys"`
+	Resource    ResourceStatus `json:"resource"`
+}
@@ -0,0 +1,43 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 5:39
+ */
+
+package domain
+
+const (
+	MaintenanceTargetProcess = "process"
+	MaintenanceTargetGroup   = "group"
+)
+
+// Maintenance 프로세스 혹은 그룹 단위의 점검 모드. 만료 시각까지 알람과 자동 재기동을 하지 않는다
+type Maintenance struct {
+	TargetType string `json:"target_type"` // process, group
+	Target     string `json:"target"`
+	Until      int64  `json:"until"` // 만료 시각 (unix millis)
+	Reason     string `json:"reason,omitempty"`
+	Created    int64  `json:"created"` // 설정 시각 (unix millis)
+}
+
+func (m Maintenance) IsExpired(now int64) bool {
+	return m.Until <= now
+}
+
+func (m Maintenance) Key() string {
+	return m.TargetType + ":" + m.Target
+}
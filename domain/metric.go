@@ -0,0 +1,66 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 6:05
+ */
+
+package domain
+
+// ProcessMetric 모니터가 측정한 가공되지 않은 리소스 값. 측정하지 못한 항목은 -1
+type ProcessMetric struct {
+	CpuPercent float64 `json:"cpu"`
+	RssKb      int64   `json:"rss_kb"`
+	FDCount    int     `json:"fd"`
+	FDLimit    int     `json:"fd_limit"` // RLIMIT_NOFILE soft limit
+	Threads    int     `json:"thread"`
+}
+
+func NewProcessMetric() *ProcessMetric {
+	return &ProcessMetric{CpuPercent: -1, RssKb: -1, FDCount: -1, FDLimit: -1, Threads: -1}
+}
+
+const (
+	MetricCpu    = "cpu"    // CPU 사용률 (%)
+	MetricRss    = "rss"    // RSS (KB)
+	MetricFd     = "fd"     // FD 개수
+	MetricThread = "thread" // thread 개수
+)
+
+func IsValidMetric(metric string) bool {
+	switch metric {
+	case MetricCpu, MetricRss, MetricFd, MetricThread:
+		return true
+	}
+	return false
+}
+
+// MetricPoint step 구간의 평균과 최대값
+type MetricPoint struct {
+	Time int64   `json:"time"` // 구간 시작 시각 (unix millis)
+	Avg  float64 `json:"avg"`
+	Max  float64 `json:"max"`
+}
+
+// MetricSeries 프로세스 하나의 metric 시계열
+type MetricSeries struct {
+	Process string        `json:"process"`
+	Metric  string        `json:"metric"`
+	StepSec int           `json:"step_sec"`
+	From    int64         `json:"from"`
+	To      int64         `json:"to"`
+	Points  []MetricPoint `json:"points"`
+}
@@ -68,10 +68,12 @@ func NewPackageReport() PackageReport {
 }
 
 type PackageSummary struct {
-	Alive int    `json:"alive"`
-	Dead  int    `json:"dead"`
-	Name  string `json:"package_name"`
-	Total int    `json:"total"`
+	Alive  int    `json:"alive"`
+	Dead   int    `json:"dead"`
+	Paused int    `json:"paused"`
+	Hung   int    `json:"hung"`
+	Name   string `json:"package_name"`
+	Total  int    `json:"total"`
 }
 
 type ProcessInfo struct {
@@ -88,6 +90,27 @@ type ProcessInfo struct {
 	QKey      string `json:"qkey"`
 	StartTime string `json:"start_time"`
 	Status    string `json:"status"`
+	// grep 패턴에 여러 프로세스가 매칭되는 경우 후보 pid 목록
+	Candidates []int `json:"candidates,omitempty"`
+	// 점검 모드라면 만료 시각과 사유
+	Maintenance string `json:"maintenance,omitempty"`
+	// 자동 재기동 정책과 상태
+	Restart *RestartState `json:"restart,omitempty"`
+	// 가장 최근의 종료 정보 (juno 가 회수한 자식 프로세스에 한함)
+	LastExit *ProcessExit `json:"last_exit,omitempty"`
+	// 발생중인 리소스 임계치 초과 상태
+	Thresholds []ThresholdBreach `json:"thresholds,omitempty"`
+	// 상태 변경을 반복하는 중이라면 flapping 정보
+	Flapping *FlapState `json:"flapping,omitempty"`
+	// hb 프로세스라면 heartbeat 상태
+	Heartbeat *HeartbeatState `json:"heartbeat,omitempty"`
+	// 임계치 평가를 위한 측정값 (linux 에서만 측정)
+	Metric *ProcessMetric `json:"-"`
+}
+
+// IsRunning ALIVE, PAUSED 혹은 HUNG 상태라면 프로세스가 존재하는 것으로 본다
+func (p ProcessInfo) IsRunning() bool {
+	return p.Status == PROC_STATUS_ALIVE || p.Status == PROC_STATUS_PAUSED || p.Status == PROC_STATUS_HUNG
 }
 
 func NewProcessInfo() *ProcessInfo {
@@ -0,0 +1,64 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 5:41
+ */
+
+package domain
+
+const (
+	PlanActionStart   = "start"
+	PlanActionStop    = "stop"
+	PlanActionRestart = "restart"
+	PlanActionWait    = "wait"
+
+	GoawayByIPC     = "ipc"
+	GoawayBySignal  = "sigusr1"
+	GoawayByShell   = "goaway.sh"
+	GoawayByNothing = "none"
+)
+
+// OperationPlan start/stop/restart 를 실행하지 않고 수행될 내용을 정리한 계획
+type OperationPlan struct {
+	Action      string      `json:"action"`
+	Stages      []PlanStage `json:"stages"`
+	Skipped     []PlanSkip  `json:"skipped"`
+	ExpectedSec int         `json:"expected_sec"` // 전체 예상 최대 소요 시간(초)
+}
+
+// PlanStage weight 그룹 단위로 동시에 수행되는 단계
+type PlanStage struct {
+	Order     int           `json:"order"`
+	Action    string        `json:"action"` // start, stop, wait
+	Weight    int           `json:"weight"`
+	Processes []PlanProcess `json:"processes"`
+	WaitSec   int           `json:"wait_sec"` // 다음 단계로 넘어가기 전 예상 최대 대기 시간(초)
+	WaitNote  string        `json:"wait_note,omitempty"`
+}
+
+type PlanProcess struct {
+	Name     string   `json:"name"`
+	Group    string   `json:"group"`
+	Pid      int      `json:"pid,omitempty"`
+	Goaway   []string `json:"goaway,omitempty"` // stop 일 경우 goaway 방법
+	StartSec int      `json:"start_sec,omitempty"`
+}
+
+type PlanSkip struct {
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+}
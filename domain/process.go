@@ -29,6 +29,9 @@ type ProcessReport struct {
 	Deployment  Deployment   `json:"deployment"`
 	Monitoring  Monitoring   `json:"monitoring"`
 	BatchJobs   BatchJobs    `json:"batch_jobs"`
+	Launch      LaunchInfo   `json:"launch"`
+	// 최근 lifecycle 이벤트 (최신순)
+	Timeline []ProcessEvent `json:"timeline"`
 }
 
 type BriefPackage struct {
@@ -0,0 +1,74 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 5:48
+ */
+
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var processNamePattern = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_.-]{0,63}$`)
+
+// ProcessRegistration 프로세스 등록 요청. clone 이 지정되면 해당 프로세스의 속성을 복사한 뒤 지정된 값으로 덮어쓴다
+type ProcessRegistration struct {
+	Process   string `json:"process"`
+	GroupId   string `json:"group_id,omitempty"` // group id 혹은 group 이름
+	Clone     string `json:"clone,omitempty"`
+	LogLevel  string `json:"loglevel,omitempty"`
+	Hb        *bool  `json:"hb,omitempty"`
+	Path      string `json:"path,omitempty"`
+	Grep      string `json:"grep,omitempty"`
+	Startmode *int   `json:"startmode,omitempty"`
+	Weight    *int   `json:"weight,omitempty"`
+	StartSec  *int   `json:"startsec,omitempty"`
+}
+
+func (r ProcessRegistration) Validate() error {
+	if !processNamePattern.MatchString(r.Process) {
+		return fmt.Errorf("invalid process name : %s", r.Process)
+	}
+	switch strings.ToLower(r.Process) {
+	case "juno", "jupiter":
+		return fmt.Errorf("reserved process name : %s", r.Process)
+	}
+	if len(r.GroupId) == 0 && len(r.Clone) == 0 {
+		return fmt.Errorf("group_id or clone is required")
+	}
+
+	if len(r.LogLevel) > 0 {
+		switch strings.ToLower(r.LogLevel) {
+		case "trace", "debug", "info", "warn", "error":
+		default:
+			return fmt.Errorf("invalid loglevel : %s", r.LogLevel)
+		}
+	}
+	if r.Startmode != nil && (*r.Startmode < 0 || *r.Startmode > 3) {
+		return fmt.Errorf("invalid startmode : %d", *r.Startmode)
+	}
+	if r.Weight != nil && *r.Weight < 0 {
+		return fmt.Errorf("invalid weight : %d", *r.Weight)
+	}
+	if r.StartSec != nil && *r.StartSec < 0 {
+		return fmt.Errorf("invalid startsec : %d", *r.StartSec)
+	}
+	return nil
+}
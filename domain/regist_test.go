@@ -0,0 +1,43 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 6:43
+ */
+
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProcessRegistrationValidate(t *testing.T) {
+	weight, negative, mode := 1, -1, 4
+
+	assert.Nil(t, ProcessRegistration{Process: "worker3", GroupId: "svc"}.Validate())
+	assert.Nil(t, ProcessRegistration{Process: "svc.worker-3", Clone: "worker1", Weight: &weight}.Validate())
+
+	assert.NotNil(t, ProcessRegistration{Process: "3worker", GroupId: "svc"}.Validate())
+	assert.NotNil(t, ProcessRegistration{Process: "work er", GroupId: "svc"}.Validate())
+	assert.NotNil(t, ProcessRegistration{Process: "Juno", GroupId: "svc"}.Validate())
+	assert.NotNil(t, ProcessRegistration{Process: "worker3"}.Validate())
+	assert.NotNil(t, ProcessRegistration{Process: "worker3", GroupId: "svc", LogLevel: "verbose"}.Validate())
+	assert.NotNil(t, ProcessRegistration{Process: "worker3", GroupId: "svc", Startmode: &mode}.Validate())
+	assert.NotNil(t, ProcessRegistration{Process: "worker3", GroupId: "svc", Weight: &negative}.Validate())
+	assert.NotNil(t, ProcessRegistration{Process: "worker3", GroupId: "svc", StartSec: &negative}.Validate())
+}
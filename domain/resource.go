@@ -0,0 +1,142 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 5:29
+ */
+
+package domain
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	IoniceClassRealtime   = "realtime"
+	IoniceClassBestEffort = "best-effort"
+	IoniceClassIdle       = "idle"
+
+	// RlimitUnlimited rlimit 값을 -1 로 지정하면 unlimited 로 간주한다
+	RlimitUnlimited = -1
+)
+
+// ResourceSpec 프로세스 기동시 적용할 rlimit, 스케쥴링, cgroup 설정
+type ResourceSpec struct {
+	RlimitNoFile *int64 `json:"rlimit_nofile,omitempty"`
+	RlimitCore   *int64 `json:"rlimit_core,omitempty"`
+	RlimitAS     *int64 `json:"rlimit_as,omitempty"`
+	Nice         *int   `json:"nice,omitempty"`
+	IoniceClass  string `json:"ionice_class,omitempty"` // realtime, best-effort, idle
+	IoniceLevel  int    `json:"ionice_level,omitempty"` // 0(highest) ~ 7(lowest)
+	CpuAffinity  string `json:"cpu_affinity,omitempty"` // e.g) 0-3,6
+	Cgroup       string `json:"cgroup,omitempty"`       // cgroup v2 root 하위 상대 경로. e.g) fatima/searchd
+	MemoryMax    string `json:"memory_max,omitempty"`   // cgroup memory.max. e.g) 2G, max
+	CpuMax       string `json:"cpu_max,omitempty"`      // cgroup cpu.max. e.g) "50000 100000", max
+}
+
+func (r ResourceSpec) IsEmpty() bool {
+	return r.RlimitNoFile == nil &&
+		r.RlimitCore == nil &&
+		r.RlimitAS == nil &&
+		r.Nice == nil &&
+		len(r.IoniceClass) == 0 &&
+		len(r.CpuAffinity) == 0 &&
+		len(r.Cgroup) == 0
+}
+
+func (r ResourceSpec) Validate() error {
+	for _, v := range []*int64{r.RlimitNoFile, r.RlimitCore, r.RlimitAS} {
+		if v != nil && *v < RlimitUnlimited {
+			return fmt.Errorf("invalid rlimit value : %d", *v)
+		}
+	}
+
+	if r.Nice != nil && (*r.Nice < -20 || *r.Nice > 19) {
+		return fmt.Errorf("invalid nice value : %d", *r.Nice)
+	}
+
+	switch r.IoniceClass {
+	case "", IoniceClassRealtime, IoniceClassBestEffort, IoniceClassIdle:
+	default:
+		return fmt.Errorf("invalid ionice class : %s", r.IoniceClass)
+	}
+	if r.IoniceLevel < 0 || r.IoniceLevel > 7 {
+		return fmt.Errorf("invalid ionice level : %d", r.IoniceLevel)
+	}
+
+	if len(r.CpuAffinity) > 0 {
+		if _, err := ParseCpuList(r.CpuAffinity); err != nil {
+			return err
+		}
+	}
+
+	if strings.Contains(r.Cgroup, "..") {
+		return fmt.Errorf("invalid cgroup path : %s", r.Cgroup)
+	}
+	if len(r.Cgroup) == 0 && (len(r.MemoryMax) > 0 || len(r.CpuMax) > 0) {
+		return fmt.Errorf("memory_max and cpu_max require cgroup")
+	}
+
+	return nil
+}
+
+// ParseCpuList parse cpu list format. e.g) "0-3,6" -> [0 1 2 3 6]
+func ParseCpuList(value string) ([]int, error) {
+	list := make([]int, 0)
+	for _, token := range strings.Split(value, ",") {
+		token = strings.TrimSpace(token)
+		if len(token) == 0 {
+			continue
+		}
+
+		from, to := token, token
+		if idx := strings.Index(token, "-"); idx > 0 {
+			from, to = token[:idx], token[idx+1:]
+		}
+
+		start, err := strconv.Atoi(from)
+		if err != nil || start < 0 {
+			return nil, fmt.Errorf("invalid cpu list : %s", value)
+		}
+		end, err := strconv.Atoi(to)
+		if err != nil || end < start {
+			return nil, fmt.Errorf("invalid cpu list : %s", value)
+		}
+		for i := start; i <= end; i++ {
+			list = append(list, i)
+		}
+	}
+
+	if len(list) == 0 {
+		return nil, fmt.Errorf("invalid cpu list : %s", value)
+	}
+	return list, nil
+}
+
+// ResourceStatus 기동중인 프로세스에 실제 적용되어 있는 리소스 정보
+type ResourceStatus struct {
+	RlimitNoFile string `json:"rlimit_nofile,omitempty"`
+	RlimitCore   string `json:"rlimit_core,omitempty"`
+	RlimitAS     string `json:"rlimit_as,omitempty"`
+	Nice         string `json:"nice,omitempty"`
+	Ionice       string `json:"ionice,omitempty"`
+	CpuAffinity  string `json:"cpu_affinity,omitempty"`
+	Cgroup       string `json:"cgroup,omitempty"`
+	MemoryMax    string `json:"memory_max,omitempty"`
+	CpuMax       string `json:"cpu_max,omitempty"`
+}
@@ -0,0 +1,96 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 5:59
+ */
+
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	RestartAlways    = "always"
+	RestartOnFailure = "on-failure" // 종료 코드를 알 수 없는 경우(juno 의 자식이 아닌 경우)는 실패로 보고 재기동한다
+	RestartNever     = "never"
+)
+
+// RestartPolicy 모니터가 죽은 프로세스를 자동으로 재기동하는 정책
+type RestartPolicy struct {
+	Mode             string  `json:"mode"`               // always, on-failure, never
+	MaxRetry         int     `json:"max_retry"`          // WindowSec 동안 허용되는 최대 재기동 횟수
+	WindowSec        int     `json:"window_sec"`         // 재기동 횟수를 세는 sliding window
+	BackoffInitialMs int     `json:"backoff_initial_ms"` // 첫번째 재기동 전 대기 시간
+	BackoffMaxMs     int     `json:"backoff_max_ms"`     // 대기 시간 상한
+	BackoffJitter    float64 `json:"backoff_jitter"`     // 0 ~ 1. 대기 시간에 +- 비율로 적용
+	StableSec        int     `json:"stable_sec"`         // 이 시간 이상 정상 동작하면 IC 를 초기화한다
+}
+
+func (r RestartPolicy) Validate() error {
+	switch r.Mode {
+	case RestartAlways, RestartOnFailure, RestartNever:
+	default:
+		return fmt.Errorf("invalid restart mode : %s", r.Mode)
+	}
+	if r.MaxRetry < 0 {
+		return fmt.Errorf("invalid max_retry : %d", r.MaxRetry)
+	}
+	if r.WindowSec < 1 {
+		return fmt.Errorf("invalid window_sec : %d", r.WindowSec)
+	}
+	if r.BackoffInitialMs < 0 || r.BackoffMaxMs < r.BackoffInitialMs {
+		return fmt.Errorf("invalid backoff : initial=%d, max=%d", r.BackoffInitialMs, r.BackoffMaxMs)
+	}
+	if r.BackoffJitter < 0 || r.BackoffJitter > 1 {
+		return fmt.Errorf("invalid backoff_jitter : %f", r.BackoffJitter)
+	}
+	if r.StableSec < 1 {
+		return fmt.Errorf("invalid stable_sec : %d", r.StableSec)
+	}
+	return nil
+}
+
+// Backoff attempt(0 부터) 번째 재기동 전 대기 시간. random 은 0 ~ 1 사이 값
+func (r RestartPolicy) Backoff(attempt int, random float64) time.Duration {
+	delay := float64(r.BackoffInitialMs)
+	for i := 0; i < attempt && delay < float64(r.BackoffMaxMs); i++ {
+		delay *= 2
+	}
+	if delay > float64(r.BackoffMaxMs) {
+		delay = float64(r.BackoffMaxMs)
+	}
+	delay += delay * r.BackoffJitter * (random*2 - 1)
+	if delay > float64(r.BackoffMaxMs) {
+		delay = float64(r.BackoffMaxMs)
+	}
+	if delay < 0 {
+		delay = 0
+	}
+	return time.Duration(delay) * time.Millisecond
+}
+
+// RestartState package report 에 표시되는 자동 재기동 상태
+type RestartState struct {
+	Policy      string `json:"policy"`
+	Attempts    int    `json:"attempts"` // window 안의 재기동 횟수
+	MaxRetry    int    `json:"max_retry"`
+	NextRestart int64  `json:"next_restart,omitempty"` // 예정된 재기동 시각 (unix millis)
+	LastRestart int64  `json:"last_restart,omitempty"`
+	GaveUp      bool   `json:"gave_up,omitempty"`
+}
@@ -0,0 +1,111 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 5:45
+ */
+
+package domain
+
+import (
+	"bytes"
+	"fmt"
+)
+
+const (
+	ProcessActionStart = "start"
+	ProcessActionStop  = "stop"
+	ProcessActionClric = "clric"
+)
+
+type OperationOutcome string
+
+const (
+	OutcomeSuccess        OperationOutcome = "SUCCESS"
+	OutcomeFail           OperationOutcome = "FAIL"
+	OutcomeAlreadyRunning OperationOutcome = "ALREADY_RUNNING"
+	OutcomeNotRunning     OperationOutcome = "NOT_RUNNING"
+	OutcomeNotPermitted   OperationOutcome = "NOT_PERMITTED"
+	OutcomeUnregisted     OperationOutcome = "UNREGISTED"
+)
+
+// ProcessResult start/stop/clric 등 프로세스 제어 명령의 프로세스별 수행 결과
+type ProcessResult struct {
+	Name       string           `json:"name"`
+	Action     string           `json:"action"`
+	Outcome    OperationOutcome `json:"outcome"`
+	OldPid     int              `json:"old_pid,omitempty"`
+	NewPid     int              `json:"new_pid,omitempty"`
+	DurationMs int64            `json:"duration_ms"`
+	Error      string           `json:"error,omitempty"`
+	Detail     string           `json:"detail,omitempty"`
+}
+
+// Text 기존 summary.message 형식의 문자열. 하위 호환을 위해서만 사용한다
+func (r ProcessResult) Text() string {
+	if r.Outcome == OutcomeUnregisted {
+		return "UNREGISTED PROCESS"
+	}
+
+	var buffer bytes.Buffer
+	switch r.Action {
+	case ProcessActionStart:
+		buffer.WriteString(fmt.Sprintf("\nSTART PROCESS : %s\n", r.Name))
+		switch r.Outcome {
+		case OutcomeAlreadyRunning:
+			buffer.WriteString(fmt.Sprintf("ALEADY RUNNING : %d", r.OldPid))
+		case OutcomeFail:
+			buffer.WriteString(fmt.Sprintf("FAIL TO EXECUTE : %s", r.Error))
+		default:
+			buffer.WriteString(fmt.Sprintf("SUCCESS : pid=%d", r.NewPid))
+		}
+	case ProcessActionStop:
+		buffer.WriteString(fmt.Sprintf("\nSTOP PROCESS : %s\n", r.Name))
+		switch r.Outcome {
+		case OutcomeNotPermitted:
+			buffer.WriteString(fmt.Sprintf("%s is not permitted for killing", r.Name))
+		case OutcomeNotRunning:
+			buffer.WriteString("NOT RUNNING\n")
+		case OutcomeFail:
+			buffer.WriteString(fmt.Sprintf("FAIL TO KILL %s[%d] : %s", r.Name, r.OldPid, r.Error))
+		default:
+			// process tree 는 detail 로만 제공한다
+			buffer.WriteString(fmt.Sprintf("KILLED %d\n", r.OldPid))
+		}
+	case ProcessActionClric:
+		buffer.WriteString(fmt.Sprintf("\nCLRIC PROCESS : %s\n", r.Name))
+	}
+	return buffer.String()
+}
+
+type ProcessResults []ProcessResult
+
+func (list ProcessResults) Text() string {
+	var buffer bytes.Buffer
+	for _, r := range list {
+		buffer.WriteString(r.Text())
+	}
+	return buffer.String()
+}
+
+func (list ProcessResults) HasFailure() bool {
+	for _, r := range list {
+		if r.Outcome == OutcomeFail || r.Outcome == OutcomeUnregisted {
+			return true
+		}
+	}
+	return false
+}
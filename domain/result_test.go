@@ -0,0 +1,43 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 9:40
+ */
+
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProcessResultsText(t *testing.T) {
+	// 기존 summary.message 와 동일한 문자열이어야 한다
+	results := ProcessResults{
+		{Name: "fcmapp", Action: ProcessActionStart, Outcome: OutcomeSuccess, NewPid: 65361},
+		{Name: "fcmapp", Action: ProcessActionStart, Outcome: OutcomeAlreadyRunning, OldPid: 65361},
+		{Name: "ifbccard", Action: ProcessActionStart, Outcome: OutcomeFail, Error: "exec failed"},
+	}
+	assert.Equal(t, "\nSTART PROCESS : fcmapp\nSUCCESS : pid=65361"+
+		"\nSTART PROCESS : fcmapp\nALEADY RUNNING : 65361"+
+		"\nSTART PROCESS : ifbccard\nFAIL TO EXECUTE : exec failed", results.Text())
+
+	stopped := ProcessResult{Name: "fcmapp", Action: ProcessActionStop, Outcome: OutcomeSuccess, OldPid: 65361,
+		Detail: "pgid=65361, pids=[65361]"}
+	assert.Equal(t, "\nSTOP PROCESS : fcmapp\nKILLED 65361\n", stopped.Text())
+}
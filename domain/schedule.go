@@ -0,0 +1,98 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 5:40
+ */
+
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	ScheduleActionStart    = "start"
+	ScheduleActionStop     = "stop"
+	ScheduleActionRestart  = "restart"
+	ScheduleActionLogLevel = "loglevel"
+
+	ScheduleStatusPending  = "PENDING"
+	ScheduleStatusRunning  = "RUNNING"
+	ScheduleStatusSuccess  = "SUCCESS"
+	ScheduleStatusFail     = "FAIL"
+	ScheduleStatusCanceled = "CANCELED"
+	ScheduleStatusMissed   = "MISSED"
+)
+
+// ScheduleRequest 예약 작업 등록 요청
+type ScheduleRequest struct {
+	Action    string `json:"action"`
+	Process   string `json:"process,omitempty"`
+	Group     string `json:"group,omitempty"`
+	LogLevel  string `json:"loglevel,omitempty"`
+	ExecuteAt string `json:"execute_at"` // yyyy-MM-dd HH:mm:ss (client timezone)
+	Comment   string `json:"comment,omitempty"`
+}
+
+func (r ScheduleRequest) Validate() error {
+	switch r.Action {
+	case ScheduleActionStart, ScheduleActionStop, ScheduleActionRestart:
+		if len(r.Process) == 0 && len(r.Group) == 0 {
+			return fmt.Errorf("process or group is required")
+		}
+	case ScheduleActionLogLevel:
+		if len(r.Process) == 0 {
+			return fmt.Errorf("process is required")
+		}
+		if len(r.LogLevel) == 0 {
+			return fmt.Errorf("loglevel is required")
+		}
+	default:
+		return fmt.Errorf("invalid action : %s", r.Action)
+	}
+
+	if len(r.Process) > 0 && len(r.Group) > 0 {
+		return fmt.Errorf("process and group cannot be specified together")
+	}
+	if strings.ToLower(r.Group) == "opm" {
+		return fmt.Errorf("OPM group not permitted")
+	}
+	return nil
+}
+
+// ScheduledOperation 지정된 시각에 한번 수행되는 프로세스 작업
+type ScheduledOperation struct {
+	Id         string `json:"id"`
+	Action     string `json:"action"`
+	Process    string `json:"process,omitempty"`
+	Group      string `json:"group,omitempty"`
+	LogLevel   string `json:"loglevel,omitempty"`
+	ExecuteAt  int64  `json:"execute_at"` // unix millis
+	Comment    string `json:"comment,omitempty"`
+	Created    int64  `json:"created"`
+	Status     string `json:"status"`
+	ExecutedAt int64  `json:"executed_at,omitempty"`
+	Result     string `json:"result,omitempty"`
+}
+
+func (s ScheduledOperation) Target() string {
+	if len(s.Group) > 0 {
+		return "group:" + s.Group
+	}
+	return s.Process
+}
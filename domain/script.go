@@ -0,0 +1,67 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 5:51
+ */
+
+package domain
+
+import "fmt"
+
+// ScriptCommand 앱 폴더의 scripts 폴더에서 발견된 운영 스크립트
+type ScriptCommand struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	File        string `json:"file"`
+}
+
+// ScriptRunRequest 운영 스크립트 실행 요청
+type ScriptRunRequest struct {
+	Process    string   `json:"process"`
+	Command    string   `json:"command"`
+	Args       []string `json:"args,omitempty"`
+	TimeoutSec int      `json:"timeout_sec,omitempty"`
+}
+
+func (r ScriptRunRequest) Validate() error {
+	if len(r.Process) == 0 {
+		return fmt.Errorf("process is required")
+	}
+	if len(r.Command) == 0 {
+		return fmt.Errorf("command is required")
+	}
+	if r.TimeoutSec < 0 {
+		return fmt.Errorf("invalid timeout : %d", r.TimeoutSec)
+	}
+	return nil
+}
+
+// ScriptRunResult 운영 스크립트 실행 결과. history 에는 stdout/stderr 를 제외하고 기록한다
+type ScriptRunResult struct {
+	Process    string   `json:"process"`
+	Command    string   `json:"command"`
+	Args       []string `json:"args,omitempty"`
+	Client     string   `json:"client,omitempty"`
+	Started    int64    `json:"started"` // unix millis
+	DurationMs int64    `json:"duration_ms"`
+	ExitCode   int      `json:"exit_code"`
+	TimedOut   bool     `json:"timed_out,omitempty"`
+	Error      string   `json:"error,omitempty"`
+	Stdout     string   `json:"stdout,omitempty"`
+	Stderr     string   `json:"stderr,omitempty"`
+	Truncated  bool     `json:"truncated,omitempty"`
+}
@@ -0,0 +1,142 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 6:05
+ */
+
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	ThresholdCpu       = "cpu"        // CPU 사용률 (%)
+	ThresholdRss       = "rss"        // RSS (MB)
+	ThresholdFd        = "fd"         // FD 개수
+	ThresholdFdPercent = "fd_percent" // RLIMIT_NOFILE 대비 FD 사용률 (%)
+	ThresholdThread    = "thread"     // thread 개수
+
+	ThresholdActionRestart = "restart"
+	ThresholdActionDump    = "dump"
+
+	ThresholdLevelWarn  = "warn"
+	ThresholdLevelMinor = "minor"
+	ThresholdLevelMajor = "major"
+)
+
+// ThresholdRule Metric 값이 Value 를 초과한 상태가 ForSec 동안 지속되면 알람을 발생시킨다
+type ThresholdRule struct {
+	Metric string  `json:"metric"`
+	Value  float64 `json:"value"`
+	ForSec int     `json:"for_sec"`
+	Level  string  `json:"level,omitempty"`  // warn(기본), minor, major
+	Action string  `json:"action,omitempty"` // restart, dump
+}
+
+func (r ThresholdRule) Validate() error {
+	switch r.Metric {
+	case ThresholdCpu, ThresholdRss, ThresholdFd, ThresholdFdPercent, ThresholdThread:
+	default:
+		return fmt.Errorf("invalid threshold metric : %s", r.Metric)
+	}
+	if r.Value <= 0 {
+		return fmt.Errorf("invalid threshold value : %v", r.Value)
+	}
+	if r.ForSec < 0 {
+		return fmt.Errorf("invalid threshold for_sec : %d", r.ForSec)
+	}
+	switch r.Level {
+	case "", ThresholdLevelWarn, ThresholdLevelMinor, ThresholdLevelMajor:
+	default:
+		return fmt.Errorf("invalid threshold level : %s", r.Level)
+	}
+	switch r.Action {
+	case "", ThresholdActionRestart, ThresholdActionDump:
+	default:
+		return fmt.Errorf("invalid threshold action : %s", r.Action)
+	}
+	return nil
+}
+
+// Key 규칙을 구분하는 키. 지속 상태를 추적하는데 사용한다
+func (r ThresholdRule) Key() string {
+	return fmt.Sprintf("%s>%v/%d", r.Metric, r.Value, r.ForSec)
+}
+
+// Measure 규칙이 비교할 값. 측정되지 않았다면 false
+func (r ThresholdRule) Measure(m ProcessMetric) (float64, bool) {
+	switch r.Metric {
+	case ThresholdCpu:
+		return m.CpuPercent, m.CpuPercent >= 0
+	case ThresholdRss:
+		return float64(m.RssKb) / 1024, m.RssKb >= 0
+	case ThresholdFd:
+		return float64(m.FDCount), m.FDCount >= 0
+	case ThresholdFdPercent:
+		if m.FDCount < 0 || m.FDLimit <= 0 {
+			return 0, false
+		}
+		return float64(m.FDCount) * 100 / float64(m.FDLimit), true
+	case ThresholdThread:
+		return float64(m.Threads), m.Threads >= 0
+	}
+	return 0, false
+}
+
+// Text 예) rss > 2048MB for 300s
+func (r ThresholdRule) Text() string {
+	unit := ""
+	switch r.Metric {
+	case ThresholdCpu, ThresholdFdPercent:
+		unit = "%"
+	case ThresholdRss:
+		unit = "MB"
+	}
+	return fmt.Sprintf("%s > %v%s for %ds", r.Metric, r.Value, unit, r.ForSec)
+}
+
+// ThresholdConfig 프로세스별 리소스 임계치 규칙
+type ThresholdConfig struct {
+	Rules []ThresholdRule `json:"rules"`
+}
+
+func (c ThresholdConfig) Validate() error {
+	keys := make(map[string]bool)
+	for _, r := range c.Rules {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+		if keys[r.Key()] {
+			return fmt.Errorf("duplicated threshold rule : %s", r.Text())
+		}
+		keys[r.Key()] = true
+	}
+	return nil
+}
+
+// ThresholdBreach 현재 발생중인 임계치 초과 상태
+type ThresholdBreach struct {
+	Rule  ThresholdRule `json:"rule"`
+	Since int64         `json:"since"` // 초과가 시작된 시각 (unix millis)
+	Value float64       `json:"value"`
+}
+
+func (b ThresholdBreach) Text() string {
+	return fmt.Sprintf("%s (current=%s)", b.Rule.Text(), strings.TrimRight(strings.TrimRight(fmt.Sprintf("%.1f", b.Value), "0"), "."))
+}
@@ -0,0 +1,32 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 5:47
+ */
+
+package domain
+
+// TrashEntry unregist 시 보관된 프로세스 아카이브 정보
+type TrashEntry struct {
+	File     string `json:"file"`
+	Process  string `json:"process"`
+	Group    string `json:"group"`
+	Revision string `json:"revision,omitempty"`
+	Size     int64  `json:"size"`
+	Created  int64  `json:"created"` // unregist 시각 (unix millis)
+	Expire   int64  `json:"expire"`  // 자동 삭제 예정 시각 (unix millis)
+}
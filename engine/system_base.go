@@ -43,6 +43,11 @@ import (
 	"github.com/fatima-go/juno/service"
 )
 
+const (
+	// SIGCHLD 와 별개로 등록된 자식 프로세스의 종료를 확인하는 주기
+	reapInterval = 5 * time.Second
+)
+
 func NewSystemBase(fatimaRuntime fatima.FatimaRuntime) *SystemBase {
 	server := new(SystemBase)
 	server.fatimaRuntime = fatimaRuntime
@@ -64,35 +69,36 @@ func (system *SystemBase) Initialize() bool {
 	go func() {
 		for range hourlyMaintainTick.C {
 			maintainRevision(system.fatimaRuntime.GetEnv())
+			service.StripTrash(system.fatimaRuntime.GetEnv())
+		}
+	}()
+
+	scheduleTick := time.NewTicker(time.Second * 5)
+	go func() {
+		for range scheduleTick.C {
+			service.ExecuteDueOperations(system.fatimaRuntime)
 		}
 	}()
 
 	if runtime.GOOS == "linux" {
-		go func() {
-			// start first measuring
-			service.GetProcessMonitor().WatchProcesses()
-		}()
-
-		SecondTick := time.NewTicker(time.Second)
-		go func() {
-			for range SecondTick.C {
-				service.GetProcessMonitor().WatchProcesses()
-			}
-		}()
+		// 종료는 pidfd/SIGCHLD 이벤트로 감지하고 전체 scan 은 fallback 으로 수행
+		go service.GetProcessMonitor().Run()
 	}
 
 	system.sigs = make(chan os.Signal, 1)
 	signal.Notify(system.sigs, syscall.SIGCHLD)
 
 	go func() {
-		for true {
-			<-system.sigs
-
-			var (
-				status syscall.WaitStatus
-				usage  syscall.Rusage
-			)
-			_, _ = syscall.Wait4(-1, &status, syscall.WNOHANG, &usage)
+		// 등록 전에 종료되어 SIGCHLD 를 놓친 자식도 회수할 수 있도록 주기적으로도 확인한다
+		ticker := time.NewTicker(reapInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-system.sigs:
+			case <-ticker.C:
+			}
+			// SIGCHLD 는 합쳐져서 전달될 수 있으므로 등록된 자식 전체를 확인한다
+			service.ReapChildren()
 		}
 	}()
 
@@ -137,6 +143,7 @@ func (system *SystemBase) SystemHAStatusChanged(newHAStatus monitor.HAStatus) {
 		return
 	}
 
+	table := service.ScanProcessTable()
 	for _, p := range yamlConfig.Processes {
 		if IsManagedOpmProcess(p) {
 			continue // skip OPM
@@ -146,17 +153,19 @@ func (system *SystemBase) SystemHAStatusChanged(newHAStatus monitor.HAStatus) {
 			continue
 		}
 
-		pid := service.GetPid(system.fatimaRuntime.GetEnv(), p)
+		reason := fmt.Sprintf("HA %s", newHAStatus)
+		pid := service.GetPidWithTable(system.fatimaRuntime.GetEnv(), p, table).Pid
 		if pid > 0 {
 			if ExistInProcessListWithPid(procList, pid) {
 				if newHAStatus == monitor.HA_STATUS_STANDBY {
 					service.KillProgram(p.GetName(), pid)
+					system.recordProcessEvent(p.GetName(), ProcessEventStopped, pid, reason)
 				}
 			} else if newHAStatus == monitor.HA_STATUS_ACTIVE {
-				service.ExecuteProgram(system.fatimaRuntime.GetEnv(), p)
+				system.executeProgram(p, reason)
 			}
 		} else if newHAStatus == monitor.HA_STATUS_ACTIVE {
-			service.ExecuteProgram(system.fatimaRuntime.GetEnv(), p)
+			system.executeProgram(p, reason)
 		}
 	}
 }
@@ -171,6 +180,7 @@ func (system *SystemBase) SystemPSStatusChanged(newPSStatus monitor.PSStatus) {
 		return
 	}
 
+	table := service.ScanProcessTable()
 	for _, p := range yamlConfig.Processes {
 		if IsManagedOpmProcess(p) {
 			continue // skip OPM
@@ -180,21 +190,36 @@ func (system *SystemBase) SystemPSStatusChanged(newPSStatus monitor.PSStatus) {
 			continue
 		}
 
-		pid := service.GetPid(system.fatimaRuntime.GetEnv(), p)
+		reason := fmt.Sprintf("PS %s", newPSStatus)
+		pid := service.GetPidWithTable(system.fatimaRuntime.GetEnv(), p, table).Pid
 		if pid > 0 {
 			if ExistInProcessListWithPid(procList, pid) {
 				if newPSStatus == monitor.PS_STATUS_SECONDARY {
 					service.KillProgram(p.GetName(), pid)
+					system.recordProcessEvent(p.GetName(), ProcessEventStopped, pid, reason)
 				}
 			} else if newPSStatus == monitor.PS_STATUS_PRIMARY {
-				service.ExecuteProgram(system.fatimaRuntime.GetEnv(), p)
+				system.executeProgram(p, reason)
 			}
 		} else if newPSStatus == monitor.PS_STATUS_PRIMARY {
-			service.ExecuteProgram(system.fatimaRuntime.GetEnv(), p)
+			system.executeProgram(p, reason)
 		}
 	}
 }
 
+// executeProgram HA/PS 상태 변경으로 프로세스를 기동하고 이벤트를 기록한다
+func (system *SystemBase) executeProgram(p fatima.FatimaPkgProc, reason string) {
+	pid, err := service.ExecuteProgram(system.fatimaRuntime.GetEnv(), p)
+	if err == nil {
+		system.recordProcessEvent(p.GetName(), ProcessEventStarted, pid, reason)
+	}
+}
+
+func (system *SystemBase) recordProcessEvent(proc, event string, pid int, reason string) {
+	service.RecordProcessEvent(system.fatimaRuntime.GetEnv(),
+		ProcessEvent{Process: proc, Event: event, Pid: pid, Actor: EventActorJuno, Reason: reason})
+}
+
 func (system *SystemBase) Shutdown() {
 	log.Info("SystemBase Shutdown()")
 }
@@ -295,6 +320,7 @@ func startDeadProcessesSerial(fatimaRuntime fatima.FatimaRuntime) {
 		return
 	}
 
+	table := service.ScanProcessTable()
 	for _, p := range yamlConfig.Processes {
 		if IsManagedOpmProcess(p) {
 			continue // skip OPM
@@ -304,7 +330,7 @@ func startDeadProcessesSerial(fatimaRuntime fatima.FatimaRuntime) {
 			log.Info("skip start process : %s", p.GetName())
 			continue
 		}
-		pid := service.GetPid(fatimaRuntime.GetEnv(), p)
+		pid := service.GetPidWithTable(fatimaRuntime.GetEnv(), p, table).Pid
 		if pid > 0 {
 			if ExistInProcessListWithPid(procList, pid) {
 				continue
@@ -0,0 +1,152 @@
+//go:build linux
+// +build linux
+
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 6:01
+ */
+
+package infra
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/fatima-go/fatima-log"
+	"golang.org/x/sys/unix"
+)
+
+// ExitWatcher pidfd 와 epoll 을 이용하여 프로세스 종료를 즉시 감지한다
+// 종료된 pid 는 Events() 채널로 전달되며 감지 이후 해당 pid 는 자동으로 watch 에서 제외된다
+type ExitWatcher struct {
+	epfd   int
+	mutex  sync.Mutex
+	pidfds map[int]int // pid -> pidfd
+	owners map[int]int // pidfd -> pid
+	events chan int
+}
+
+func NewExitWatcher() (*ExitWatcher, error) {
+	// 커널이 pidfd_open 을 지원하는지 자기 자신으로 확인한다 (linux 5.3+)
+	fd, err := unix.PidfdOpen(os.Getpid(), 0)
+	if err != nil {
+		return nil, fmt.Errorf("pidfd_open is not supported : %s", err.Error())
+	}
+	_ = unix.Close(fd)
+
+	epfd, err := unix.EpollCreate1(unix.EPOLL_CLOEXEC)
+	if err != nil {
+		return nil, fmt.Errorf("fail to create epoll : %s", err.Error())
+	}
+
+	watcher := &ExitWatcher{}
+	watcher.epfd = epfd
+	watcher.pidfds = make(map[int]int)
+	watcher.owners = make(map[int]int)
+	watcher.events = make(chan int, 64)
+	go watcher.run()
+	return watcher, nil
+}
+
+func (w *ExitWatcher) Events() <-chan int {
+	return w.events
+}
+
+// Watch pid 의 종료 감시를 시작한다. 이미 종료된 pid 라면 즉시 이벤트를 전달한다
+func (w *ExitWatcher) Watch(pid int) error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if _, ok := w.pidfds[pid]; ok {
+		return nil
+	}
+
+	fd, err := unix.PidfdOpen(pid, 0)
+	if err != nil {
+		if errors.Is(err, unix.ESRCH) {
+			go w.publish(pid)
+			return nil
+		}
+		return fmt.Errorf("fail to open pidfd %d : %s", pid, err.Error())
+	}
+
+	event := unix.EpollEvent{Events: unix.EPOLLIN, Fd: int32(fd)}
+	err = unix.EpollCtl(w.epfd, unix.EPOLL_CTL_ADD, fd, &event)
+	if err != nil {
+		_ = unix.Close(fd)
+		return fmt.Errorf("fail to add pidfd %d to epoll : %s", pid, err.Error())
+	}
+
+	w.pidfds[pid] = fd
+	w.owners[fd] = pid
+	return nil
+}
+
+// Unwatch pid 감시를 중지한다
+func (w *ExitWatcher) Unwatch(pid int) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	w.release(pid)
+}
+
+func (w *ExitWatcher) release(pid int) {
+	fd, ok := w.pidfds[pid]
+	if !ok {
+		return
+	}
+	_ = unix.EpollCtl(w.epfd, unix.EPOLL_CTL_DEL, fd, nil)
+	_ = unix.Close(fd)
+	delete(w.pidfds, pid)
+	delete(w.owners, fd)
+}
+
+func (w *ExitWatcher) publish(pid int) {
+	w.events <- pid
+}
+
+func (w *ExitWatcher) run() {
+	events := make([]unix.EpollEvent, 32)
+	for {
+		n, err := unix.EpollWait(w.epfd, events, -1)
+		if err != nil {
+			if errors.Is(err, unix.EINTR) {
+				continue
+			}
+			log.Error("exit watcher epoll wait fail : %s", err.Error())
+			return
+		}
+
+		exited := make([]int, 0, n)
+		w.mutex.Lock()
+		for i := 0; i < n; i++ {
+			pid, ok := w.owners[int(events[i].Fd)]
+			if !ok {
+				continue
+			}
+			w.release(pid)
+			exited = append(exited, pid)
+		}
+		w.mutex.Unlock()
+
+		for _, pid := range exited {
+			w.publish(pid)
+		}
+	}
+}
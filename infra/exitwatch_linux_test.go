@@ -0,0 +1,61 @@
+//go:build linux
+// +build linux
+
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 6:01
+ */
+
+package infra
+
+import (
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExitWatcher(t *testing.T) {
+	watcher, err := NewExitWatcher()
+	if err != nil {
+		t.Skipf("pidfd not available : %s", err.Error())
+	}
+
+	cmd := exec.Command("sleep", "0.2")
+	assert.Nil(t, cmd.Start())
+	pid := cmd.Process.Pid
+	assert.Nil(t, watcher.Watch(pid))
+
+	select {
+	case exited := <-watcher.Events():
+		assert.Equal(t, pid, exited)
+	case <-time.After(3 * time.Second):
+		t.Fatal("exit event is not delivered")
+	}
+	_ = cmd.Wait()
+
+	// 이미 종료된 pid 는 즉시 통지된다
+	assert.Nil(t, watcher.Watch(pid))
+	select {
+	case exited := <-watcher.Events():
+		assert.Equal(t, pid, exited)
+	case <-time.After(time.Second):
+		t.Fatal("exit event for dead pid is not delivered")
+	}
+}
@@ -0,0 +1,47 @@
+//go:build darwin
+// +build darwin
+
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 6:01
+ */
+
+package infra
+
+import (
+	"fmt"
+)
+
+// ExitWatcher DARWIN not support pidfd. 주기적인 polling 으로만 종료를 감지한다
+type ExitWatcher struct {
+}
+
+func NewExitWatcher() (*ExitWatcher, error) {
+	return nil, fmt.Errorf("exit watcher is not supported on darwin")
+}
+
+func (w *ExitWatcher) Events() <-chan int {
+	return nil
+}
+
+func (w *ExitWatcher) Watch(pid int) error {
+	return fmt.Errorf("exit watcher is not supported on darwin")
+}
+
+func (w *ExitWatcher) Unwatch(pid int) {
+}
@@ -90,6 +90,21 @@ func (i SystemInspector) CheckProcessRunningByPid(procName string, pid int) bool
 	return false
 }
 
+// IsJavaProcess pid 가 psname 으로 procName 을 가지는 java 프로세스인지 여부
+func (i SystemInspector) IsJavaProcess(procName string, pid int) bool {
+	contents, err := os.ReadFile(fmt.Sprintf("/proc/%d/status", pid))
+	if err != nil {
+		return false
+	}
+	for _, line := range strings.Split(string(contents), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) > 1 && fields[0] == "Name:" {
+			return fields[1] == "java" && checkJavaPsName(procName, pid)
+		}
+	}
+	return false
+}
+
 func checkJavaPsName(procName string, pid int) bool {
 	statusFile := fmt.Sprintf("/proc/%d/cmdline", pid)
 	contents, err := os.ReadFile(statusFile)
@@ -109,10 +124,11 @@ func (i SystemInspector) MeasureProcessStatus(list []*domain.ProcessInfo, loc *t
 
 	for i := 0; i < len(list); i++ {
 		proc := list[i]
-		if proc.Status != domain.PROC_STATUS_ALIVE {
+		if !proc.IsRunning() {
 			continue
 		}
 
+		proc.Metric = domain.NewProcessMetric()
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
@@ -120,6 +136,7 @@ func (i SystemInspector) MeasureProcessStatus(list []*domain.ProcessInfo, loc *t
 			countFD(proc)
 			countThread(proc)
 			fillStat(proc, loc)
+			fillFDLimit(proc)
 		}()
 	}
 
@@ -175,6 +192,7 @@ func fillCpuUsage(factor1 string, factor2 string, proc *domain.ProcessInfo) {
 	procTime2 := utime + stime
 	if procTime2-procTime1 == 0 {
 		proc.CpuUtil = "0.0"
+		proc.Metric.CpuPercent = 0
 		return
 	}
 
@@ -182,6 +200,7 @@ func fillCpuUsage(factor1 string, factor2 string, proc *domain.ProcessInfo) {
 	totalSystemCpu2 := user + nice + system + idle
 	if totalSystemCpu2-totalSystemCpu1 == 0 {
 		proc.CpuUtil = "0.0"
+		proc.Metric.CpuPercent = 0
 		return
 	}
 
@@ -193,6 +212,7 @@ func fillCpuUsage(factor1 string, factor2 string, proc *domain.ProcessInfo) {
 	var util float64
 	util = float64(procTime2-procTime1) * 100 / float64(totalSystemCpu2-totalSystemCpu1)
 	proc.CpuUtil = fmt.Sprintf("%.1f", util*float64(runtime.NumCPU()))
+	proc.Metric.CpuPercent = util * float64(runtime.NumCPU())
 }
 
 func getSystemUptime() int {
@@ -250,6 +270,7 @@ func fillRSS(proc *domain.ProcessInfo) {
 				// e.g) 16592 kB
 				v, _ := strconv.Atoi(fields[1])
 				proc.Memory = lib.FormatBytes(v * 1024)
+				proc.Metric.RssKb = int64(v)
 				return
 			}
 		}
@@ -257,7 +278,7 @@ func fillRSS(proc *domain.ProcessInfo) {
 }
 
 func countFD(info *domain.ProcessInfo) {
-	if info.Status != domain.PROC_STATUS_ALIVE {
+	if !info.IsRunning() {
 		return
 	}
 
@@ -269,11 +290,33 @@ func countFD(info *domain.ProcessInfo) {
 	files, err := os.ReadDir(filePath)
 	if err == nil {
 		info.FDCount = fmt.Sprintf("%d", len(files))
+		info.Metric.FDCount = len(files)
+	}
+}
+
+// fillFDLimit /proc/<pid>/limits 의 Max open files soft limit
+func fillFDLimit(info *domain.ProcessInfo) {
+	contents, err := os.ReadFile(filepath.Join("/proc", info.Pid, "limits"))
+	if err != nil {
+		return
+	}
+	for _, line := range strings.Split(string(contents), "\n") {
+		if !strings.HasPrefix(line, "Max open files") {
+			continue
+		}
+		// Max open files            1024                 524288               files
+		fields := strings.Fields(strings.TrimPrefix(line, "Max open files"))
+		if len(fields) > 0 {
+			if v, err := strconv.Atoi(fields[0]); err == nil {
+				info.Metric.FDLimit = v
+			}
+		}
+		return
 	}
 }
 
 func countThread(info *domain.ProcessInfo) {
-	if info.Status != domain.PROC_STATUS_ALIVE {
+	if !info.IsRunning() {
 		return
 	}
 
@@ -285,5 +328,6 @@ func countThread(info *domain.ProcessInfo) {
 	files, err := os.ReadDir(filePath)
 	if err == nil {
 		info.Thread = fmt.Sprintf("%d", len(files))
+		info.Metric.Threads = len(files)
 	}
 }
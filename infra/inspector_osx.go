@@ -26,6 +26,7 @@ package infra
 import (
 	"bufio"
 	"fmt"
+	"path/filepath"
 	"regexp"
 	"strconv"
 	"strings"
@@ -73,6 +74,19 @@ func (i SystemInspector) CheckProcessRunningByPid(procName string, pid int) bool
 	return found
 }
 
+// IsJavaProcess pid 가 psname 으로 procName 을 가지는 java 프로세스인지 여부
+func (i SystemInspector) IsJavaProcess(procName string, pid int) bool {
+	out, err := lib.ExecuteShell(fmt.Sprintf("ps -ww -o command= -p %d", pid))
+	if err != nil {
+		return false
+	}
+	fields := strings.Fields(out)
+	if len(fields) == 0 || filepath.Base(fields[0]) != "java" {
+		return false
+	}
+	return strings.Contains(out, "psname="+procName) || strings.Contains(out, "pscategory="+procName)
+}
+
 func (i SystemInspector) MeasureProcessStatus(list []*domain.ProcessInfo, loc *time.Location) {
 	cmd := fmt.Sprintf("ps -v -o etime -u %d",
 		i.fatimaRuntime.GetEnv().GetSystemProc().GetUid())
@@ -90,7 +104,7 @@ func (i SystemInspector) MeasureProcessStatus(list []*domain.ProcessInfo, loc *t
 }
 
 func countFD(info *domain.ProcessInfo) {
-	if info.Status != domain.PROC_STATUS_ALIVE {
+	if !info.IsRunning() {
 		return
 	}
 
@@ -0,0 +1,134 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 6:32
+ */
+
+package infra
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"time"
+
+	"github.com/fatima-go/juno/domain"
+	"github.com/fatima-go/juno/launch"
+)
+
+const (
+	// helper 의 exec 결과를 기다리는 최대 시간
+	launchHelperConfirmTimeout = 5 * time.Second
+)
+
+// helperPath helper 로 실행할 juno 바이너리
+var helperPath = launchHelperPath
+
+// LaunchHelperSpec helper 가 exec 직전에 자기 자신에게 적용할 속성
+type LaunchHelperSpec struct {
+	Umask    *int
+	Resource *domain.ResourceSpec // rlimit, nice, ionice, cpu affinity (cgroup 은 clone 시점에 적용)
+}
+
+func (s LaunchHelperSpec) IsEmpty() bool {
+	return s.Umask == nil && !hasProcessResource(s.Resource)
+}
+
+// hasProcessResource cgroup 을 제외하고 프로세스 자신에게 적용할 리소스가 있는지 여부
+func hasProcessResource(r *domain.ResourceSpec) bool {
+	if r == nil {
+		return false
+	}
+	return r.RlimitNoFile != nil || r.RlimitCore != nil || r.RlimitAS != nil ||
+		r.Nice != nil || len(r.IoniceClass) > 0 || len(r.CpuAffinity) > 0
+}
+
+// WrapLaunchCommand umask, rlimit, nice 등 process/thread 단위 속성을 juno 가 아닌 child 에게만 적용하기 위해
+// juno 자신을 helper 로 끼워 넣는다 (launch 패키지 참고). helper 는 속성을 적용한 후 target 을 exec 하므로 pid 는 동일하고
+// 이후 생성되는 thread 와 자손 프로세스 모두 속성을 상속 받는다.
+// 리턴되는 함수는 cmd.Start() 이후 반드시 호출되어야 하며, helper 가 exec 직전임을 알리지 않았다면 에러를 리턴한다
+func WrapLaunchCommand(cmd *exec.Cmd, spec LaunchHelperSpec) (func(started bool) error, error) {
+	noop := func(bool) error { return nil }
+	if spec.IsEmpty() {
+		return noop, nil
+	}
+	if cmd.Err != nil {
+		return noop, cmd.Err
+	}
+	// exec 실패는 helper 안에서 발생하므로 미리 확인한다
+	if err := checkExecutable(cmd.Path); err != nil {
+		return noop, err
+	}
+
+	helper := launch.Spec{Umask: spec.Umask}
+	if hasProcessResource(spec.Resource) {
+		if err := buildHelperResource(*spec.Resource, &helper); err != nil {
+			return noop, err
+		}
+	}
+	moveCredentialToHelper(cmd, &helper)
+
+	// helper 는 exec 직전에 ReadyMessage 를, 실패하면 사유를 status pipe 에 쓴다. exec 으로 pipe 가 닫힌다 (CLOEXEC)
+	r, w, err := os.Pipe()
+	if err != nil {
+		return noop, fmt.Errorf("fail to create status pipe : %s", err.Error())
+	}
+	helper.StatusFd = 3 + len(cmd.ExtraFiles)
+	cmd.ExtraFiles = append(cmd.ExtraFiles, w)
+
+	b, err := json.Marshal(helper)
+	if err != nil {
+		r.Close()
+		w.Close()
+		return noop, fmt.Errorf("fail to build launch spec : %s", err.Error())
+	}
+
+	env := cmd.Env
+	if env == nil {
+		env = os.Environ()
+	}
+	cmd.Env = append(env, launch.SpecEnv+"="+string(b))
+	cmd.Args = append([]string{"juno", launch.HelperArg, cmd.Path}, cmd.Args...)
+	cmd.Path = helperPath()
+
+	return func(started bool) error {
+		w.Close()
+		defer r.Close()
+		if !started {
+			return nil
+		}
+		_ = r.SetReadDeadline(time.Now().Add(launchHelperConfirmTimeout))
+		msg, err := io.ReadAll(r)
+		if err != nil {
+			return fmt.Errorf("fail to confirm launch helper : %s", err.Error())
+		}
+		return launch.ParseStatus(string(msg))
+	}, nil
+}
+
+func checkExecutable(path string) error {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if fi.IsDir() || fi.Mode()&0111 == 0 {
+		return fmt.Errorf("%s is not executable", path)
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+//go:build linux
+// +build linux
+
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 6:32
+ */
+
+package infra
+
+import (
+	"os/exec"
+
+	"github.com/fatima-go/juno/launch"
+)
+
+// launchHelperPath juno 바이너리가 교체되어도 실행중인 이미지를 사용한다
+func launchHelperPath() string {
+	return "/proc/self/exe"
+}
+
+// moveCredentialToHelper 사용자 변경은 helper 가 속성을 적용한 후 수행한다
+func moveCredentialToHelper(cmd *exec.Cmd, spec *launch.Spec) {
+	if cmd.SysProcAttr == nil || cmd.SysProcAttr.Credential == nil {
+		return
+	}
+	spec.Credential = cmd.SysProcAttr.Credential
+	cmd.SysProcAttr.Credential = nil
+}
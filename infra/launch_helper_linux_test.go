@@ -0,0 +1,181 @@
+//go:build linux
+// +build linux
+
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 6:32
+ */
+
+package infra
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"testing"
+
+	"github.com/fatima-go/juno/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+// launch helper 는 실제 juno 바이너리로 확인한다. fatima-core 의 초기화보다 helper 가 먼저 동작해야 한다
+func TestMain(m *testing.M) {
+	dir, err := os.MkdirTemp("", "juno-helper")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "fail to make temp dir : %s\n", err.Error())
+		os.Exit(1)
+	}
+	bin, err := buildJunoBinary(dir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "fail to build juno : %s\n", err.Error())
+		os.Exit(1)
+	}
+	helperPath = func() string { return bin }
+	code := m.Run()
+	_ = os.RemoveAll(dir)
+	os.Exit(code)
+}
+
+func buildJunoBinary(dir string) (string, error) {
+	gomod, err := exec.Command("go", "env", "GOMOD").Output()
+	if err != nil {
+		return "", err
+	}
+	bin := filepath.Join(dir, "juno")
+	cmd := exec.Command("go", "build", "-o", bin, ".")
+	cmd.Dir = filepath.Dir(strings.TrimSpace(string(gomod)))
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return "", fmt.Errorf("%s %s", err.Error(), string(out))
+	}
+	return bin, nil
+}
+
+func TestWrapLaunchCommand(t *testing.T) {
+	cmd := exec.Command("/bin/sh", "-c", "umask; echo $JUNO_LAUNCH_SPEC")
+	mask := 027
+	confirm, err := WrapLaunchCommand(cmd, LaunchHelperSpec{Umask: &mask})
+	assert.Nil(t, err)
+
+	stdout, _ := cmd.StdoutPipe()
+	assert.Nil(t, cmd.Start())
+	assert.Nil(t, confirm(true))
+	out, _ := io.ReadAll(stdout)
+	assert.Nil(t, cmd.Wait())
+	lines := strings.Split(strings.TrimSpace(string(out)), "\n")
+	assert.Equal(t, "0027", lines[0])
+	// helper 전용 환경변수는 target 에게 전달되지 않는다
+	assert.Equal(t, 1, len(lines))
+
+	_, err = WrapLaunchCommand(exec.Command("/not/exist/program"), LaunchHelperSpec{Umask: &mask})
+	assert.NotNil(t, err)
+}
+
+func TestWrapLaunchCommandResource(t *testing.T) {
+	// 자손 프로세스(cut)도 nice 와 rlimit 을 상속 받는다
+	cmd := exec.Command("/bin/sh", "-c", "ulimit -n; cut -d' ' -f19 /proc/self/stat")
+	nofile := int64(512)
+	nice := 5
+	confirm, err := WrapLaunchCommand(cmd, LaunchHelperSpec{Resource: &domain.ResourceSpec{RlimitNoFile: &nofile, Nice: &nice}})
+	assert.Nil(t, err)
+
+	stdout, _ := cmd.StdoutPipe()
+	assert.Nil(t, cmd.Start())
+	assert.Nil(t, confirm(true))
+	out, _ := io.ReadAll(stdout)
+	assert.Nil(t, cmd.Wait())
+	assert.Equal(t, "512\n5", strings.TrimSpace(string(out)))
+
+	// 적용에 실패하면 target 은 exec 되지 않고 에러가 보고된다
+	cmd = exec.Command("/bin/true")
+	invalid := int64(1) << 40
+	confirm, err = WrapLaunchCommand(cmd, LaunchHelperSpec{Resource: &domain.ResourceSpec{RlimitNoFile: &invalid}})
+	assert.Nil(t, err)
+	assert.Nil(t, cmd.Start())
+	assert.NotNil(t, confirm(true))
+	assert.NotNil(t, cmd.Wait())
+}
+
+func TestWrapLaunchCommandCredential(t *testing.T) {
+	if os.Getuid() != 0 {
+		t.Skip("root required")
+	}
+
+	// 리소스를 적용한 후 사용자를 변경한다
+	cmd := exec.Command("/bin/sh", "-c", "id -u; ulimit -n")
+	cmd.SysProcAttr = &syscall.SysProcAttr{Credential: &syscall.Credential{Uid: 65534, Gid: 65534}}
+	nofile := int64(256)
+	confirm, err := WrapLaunchCommand(cmd, LaunchHelperSpec{Resource: &domain.ResourceSpec{RlimitNoFile: &nofile}})
+	assert.Nil(t, err)
+	assert.Nil(t, cmd.SysProcAttr.Credential)
+
+	stdout, _ := cmd.StdoutPipe()
+	assert.Nil(t, cmd.Start())
+	assert.Nil(t, confirm(true))
+	out, _ := io.ReadAll(stdout)
+	assert.Nil(t, cmd.Wait())
+	assert.Equal(t, "65534\n256", strings.TrimSpace(string(out)))
+}
+
+func TestWrapLaunchCommandSilentExit(t *testing.T) {
+	// helper 가 exec 직전임을 알리지 않고 종료했다면 실패로 본다
+	saved := helperPath
+	helperPath = func() string { return "/bin/true" }
+	defer func() { helperPath = saved }()
+
+	mask := 022
+	cmd := exec.Command("/bin/sleep", "1")
+	confirm, err := WrapLaunchCommand(cmd, LaunchHelperSpec{Umask: &mask})
+	assert.Nil(t, err)
+	assert.Nil(t, cmd.Start())
+	assert.NotNil(t, confirm(true))
+	_ = cmd.Wait()
+}
+
+func TestWrapLaunchCommandLimits(t *testing.T) {
+	// juno 바이너리를 helper 로 기동한 target 의 /proc/<pid>/limits 로 적용 여부를 확인한다
+	cmd := exec.Command("/bin/sleep", "5")
+	nofile := int64(300)
+	core := int64(0)
+	confirm, err := WrapLaunchCommand(cmd, LaunchHelperSpec{Resource: &domain.ResourceSpec{RlimitNoFile: &nofile, RlimitCore: &core}})
+	assert.Nil(t, err)
+	assert.Nil(t, cmd.Start())
+	defer func() {
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+	}()
+	assert.Nil(t, confirm(true))
+
+	b, err := os.ReadFile(fmt.Sprintf("/proc/%d/limits", cmd.Process.Pid))
+	assert.Nil(t, err)
+	limits := make(map[string][]string)
+	for _, line := range strings.Split(string(b), "\n") {
+		if strings.HasPrefix(line, "Max open files") || strings.HasPrefix(line, "Max core file size") {
+			limits[line[:25]] = strings.Fields(line[25:])
+		}
+	}
+	assert.Equal(t, []string{"300", "300", "files"}, limits["Max open files           "])
+	assert.Equal(t, []string{"0", "0", "bytes"}, limits["Max core file size       "])
+
+	// exec 된 target 은 sleep 이다
+	comm, _ := os.ReadFile(fmt.Sprintf("/proc/%d/comm", cmd.Process.Pid))
+	assert.Equal(t, "sleep", strings.TrimSpace(string(comm)))
+}
@@ -0,0 +1,43 @@
+//go:build darwin
+// +build darwin
+
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 6:32
+ */
+
+package infra
+
+import (
+	"os"
+	"os/exec"
+
+	"github.com/fatima-go/juno/launch"
+)
+
+func launchHelperPath() string {
+	path, err := os.Executable()
+	if err != nil {
+		return os.Args[0]
+	}
+	return path
+}
+
+// moveCredentialToHelper DARWIN 은 리소스 적용을 지원하지 않으므로 clone 시점에 사용자를 변경한다
+func moveCredentialToHelper(cmd *exec.Cmd, spec *launch.Spec) {
+}
@@ -0,0 +1,229 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 5:35
+ */
+
+package infra
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const psNamePrefix = "psname="
+
+// ProcessEntry 시스템에서 수집된 프로세스 하나의 pid, ppid, command line
+type ProcessEntry struct {
+	Pid     int
+	Ppid    int
+	Pgid    int
+	State   string
+	Args    []string
+	Cmdline string
+}
+
+// ProcessTable 한 시점에 수집된 프로세스 목록. 한번 scan 후 여러 프로세스 매칭에 공유한다
+type ProcessTable struct {
+	entries []ProcessEntry
+}
+
+// PidMatch 매칭 결과. 후보가 여럿이면 Ambiguous 가 true 이며 Pid 는 대표 pid 이다
+type PidMatch struct {
+	Pid        int
+	Candidates []int
+	Ambiguous  bool
+}
+
+// ProcessMatcher grep 패턴을 해석한 매처. psname=xxx 형태는 인자 단위 정확히 일치, 그 외는 정규식으로 매칭한다
+type ProcessMatcher struct {
+	pattern string
+	psName  string
+	regex   *regexp.Regexp
+}
+
+func NewProcessMatcher(pattern string) *ProcessMatcher {
+	pattern = strings.TrimSpace(pattern)
+	m := &ProcessMatcher{pattern: pattern}
+	if strings.HasPrefix(pattern, psNamePrefix) && !strings.ContainsAny(pattern, " \t") {
+		m.psName = pattern
+		return m
+	}
+
+	regex, err := regexp.Compile(pattern)
+	if err != nil {
+		// 정규식이 아니라면 문자열 그대로 매칭
+		regex = regexp.MustCompile(regexp.QuoteMeta(pattern))
+	}
+	m.regex = regex
+	return m
+}
+
+// NewPsNameMatcher fatima java 프로세스의 -Dpsname=<proc> 인자 매처
+func NewPsNameMatcher(proc string) *ProcessMatcher {
+	return NewProcessMatcher(psNamePrefix + proc)
+}
+
+func (m *ProcessMatcher) String() string {
+	return m.pattern
+}
+
+func (m *ProcessMatcher) Match(entry ProcessEntry) bool {
+	if len(m.pattern) == 0 {
+		return false
+	}
+
+	if len(m.psName) > 0 {
+		for _, arg := range entry.Args {
+			if arg == m.psName || strings.HasSuffix(arg, "-D"+m.psName) {
+				return true
+			}
+		}
+		return false
+	}
+	return m.regex.MatchString(entry.Cmdline)
+}
+
+// ScanProcessTable 현재 시스템의 프로세스 목록을 수집한다. juno 자신은 제외된다
+func ScanProcessTable() (*ProcessTable, error) {
+	entries, err := scanProcessEntries()
+	if err != nil {
+		return nil, fmt.Errorf("fail to scan process table : %s", err.Error())
+	}
+
+	self := os.Getpid()
+	table := &ProcessTable{entries: make([]ProcessEntry, 0, len(entries))}
+	for _, e := range entries {
+		if e.Pid == self {
+			continue
+		}
+		table.entries = append(table.entries, e)
+	}
+	sort.Slice(table.entries, func(i, j int) bool {
+		return table.entries[i].Pid < table.entries[j].Pid
+	})
+	return table, nil
+}
+
+func (t *ProcessTable) Size() int {
+	if t == nil {
+		return 0
+	}
+	return len(t.entries)
+}
+
+// Find 매칭되는 모든 후보를 찾는다
+// 후보가 여럿일 경우 다른 후보의 자식이 아닌(wrapper 등) 최상위 후보가 하나라면 그것을 대표 pid 로 하고,
+// 그렇지 않다면 가장 작은 pid 를 대표로 한다. 두 경우 모두 Ambiguous 로 표시된다
+func (t *ProcessTable) Find(matcher *ProcessMatcher) PidMatch {
+	match := PidMatch{Candidates: make([]int, 0)}
+	if t == nil || matcher == nil {
+		return match
+	}
+
+	parents := make(map[int]int)
+	for _, e := range t.entries {
+		if matcher.Match(e) {
+			match.Candidates = append(match.Candidates, e.Pid)
+			parents[e.Pid] = e.Ppid
+		}
+	}
+
+	switch len(match.Candidates) {
+	case 0:
+		return match
+	case 1:
+		match.Pid = match.Candidates[0]
+		return match
+	}
+
+	match.Ambiguous = true
+	match.Pid = match.Candidates[0]
+	roots := make([]int, 0)
+	for _, pid := range match.Candidates {
+		if _, ok := parents[parents[pid]]; !ok {
+			roots = append(roots, pid)
+		}
+	}
+	if len(roots) == 1 {
+		match.Pid = roots[0]
+	}
+	return match
+}
+
+// IsStopped SIGSTOP 등으로 정지된 상태인지 여부 (tracing stop 은 제외)
+func (e ProcessEntry) IsStopped() bool {
+	return strings.HasPrefix(e.State, "T")
+}
+
+func (t *ProcessTable) Get(pid int) (ProcessEntry, bool) {
+	if t != nil {
+		for _, e := range t.entries {
+			if e.Pid == pid {
+				return e, true
+			}
+		}
+	}
+	return ProcessEntry{}, false
+}
+
+// Descendants pid 의 모든 자손 pid 를 찾는다 (pid 자신은 제외)
+func (t *ProcessTable) Descendants(pid int) []int {
+	list := make([]int, 0)
+	if t == nil {
+		return list
+	}
+
+	children := make(map[int][]int)
+	for _, e := range t.entries {
+		children[e.Ppid] = append(children[e.Ppid], e.Pid)
+	}
+
+	queue := []int{pid}
+	visited := map[int]bool{pid: true}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, child := range children[current] {
+			if visited[child] {
+				continue
+			}
+			visited[child] = true
+			list = append(list, child)
+			queue = append(queue, child)
+		}
+	}
+	sort.Ints(list)
+	return list
+}
+
+// GroupMembers 해당 process group 에 속한 pid 목록
+func (t *ProcessTable) GroupMembers(pgid int) []int {
+	list := make([]int, 0)
+	if t == nil || pgid < 1 {
+		return list
+	}
+	for _, e := range t.entries {
+		if e.Pgid == pgid {
+			list = append(list, e.Pid)
+		}
+	}
+	return list
+}
@@ -0,0 +1,88 @@
+//go:build linux
+// +build linux
+
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 5:35
+ */
+
+package infra
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// scanProcessEntries /proc/<pid>/cmdline, /proc/<pid>/stat 을 읽어 프로세스 목록을 만든다
+func scanProcessEntries() ([]ProcessEntry, error) {
+	dirs, err := os.ReadDir("/proc")
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]ProcessEntry, 0, len(dirs))
+	for _, d := range dirs {
+		pid, err := strconv.Atoi(d.Name())
+		if err != nil || !d.IsDir() {
+			continue
+		}
+
+		b, err := os.ReadFile(fmt.Sprintf("/proc/%d/cmdline", pid))
+		if err != nil || len(b) == 0 {
+			// 이미 종료되었거나 kernel thread
+			continue
+		}
+
+		args := strings.Split(string(bytes.TrimRight(b, "\x00")), "\x00")
+		state, ppid, pgid := readProcessStat(pid)
+		entries = append(entries, ProcessEntry{
+			Pid:     pid,
+			Ppid:    ppid,
+			Pgid:    pgid,
+			State:   state,
+			Args:    args,
+			Cmdline: strings.Join(args, " "),
+		})
+	}
+	return entries, nil
+}
+
+// readProcessStat /proc/<pid>/stat 에서 state, ppid, pgrp 를 읽는다
+func readProcessStat(pid int) (string, int, int) {
+	b, err := os.ReadFile(fmt.Sprintf("/proc/%d/stat", pid))
+	if err != nil {
+		return "", 0, 0
+	}
+
+	// comm 필드에 공백, 괄호가 포함될 수 있으므로 마지막 ')' 이후부터 해석한다
+	s := string(b)
+	idx := strings.LastIndex(s, ")")
+	if idx < 0 {
+		return "", 0, 0
+	}
+	fields := strings.Fields(s[idx+1:])
+	if len(fields) < 3 {
+		return "", 0, 0
+	}
+	ppid, _ := strconv.Atoi(fields[1])
+	pgid, _ := strconv.Atoi(fields[2])
+	return fields[0], ppid, pgid
+}
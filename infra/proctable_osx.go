@@ -0,0 +1,65 @@
+//go:build darwin
+// +build darwin
+
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 5:35
+ */
+
+package infra
+
+import (
+	"bufio"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+// scanProcessEntries darwin 은 /proc 이 없으므로 ps 를 한번만 실행하여 목록을 만든다
+func scanProcessEntries() ([]ProcessEntry, error) {
+	out, err := exec.Command("ps", "-axww", "-o", "pid=,ppid=,pgid=,stat=,command=").Output()
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]ProcessEntry, 0)
+	scanner := bufio.NewScanner(strings.NewReader(string(out)))
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 5 {
+			continue
+		}
+		pid, err := strconv.Atoi(fields[0])
+		if err != nil {
+			continue
+		}
+		ppid, _ := strconv.Atoi(fields[1])
+		pgid, _ := strconv.Atoi(fields[2])
+		args := fields[4:]
+		entries = append(entries, ProcessEntry{
+			Pid:     pid,
+			Ppid:    ppid,
+			Pgid:    pgid,
+			State:   fields[3],
+			Args:    args,
+			Cmdline: strings.Join(args, " "),
+		})
+	}
+	return entries, scanner.Err()
+}
@@ -0,0 +1,61 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 5:35
+ */
+
+package infra
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestEntry(pid, ppid int, cmdline string) ProcessEntry {
+	args := strings.Fields(cmdline)
+	return ProcessEntry{Pid: pid, Ppid: ppid, Args: args, Cmdline: cmdline}
+}
+
+func TestProcessTableFind(t *testing.T) {
+	table := &ProcessTable{entries: []ProcessEntry{
+		newTestEntry(100, 1, "java -Dpsname=order -jar order.jar"),
+		newTestEntry(101, 1, "java -Dpsname=orderbatch -jar orderbatch.jar"),
+		newTestEntry(200, 1, "/bin/sh -c redis-server /etc/redis.conf"),
+		newTestEntry(201, 200, "redis-server /etc/redis.conf"),
+		newTestEntry(300, 1, "worker --id=1"),
+		newTestEntry(301, 1, "worker --id=2"),
+	}}
+
+	match := table.Find(NewPsNameMatcher("order"))
+	assert.Equal(t, 100, match.Pid)
+	assert.False(t, match.Ambiguous)
+
+	match = table.Find(NewProcessMatcher("redis-server /etc/redis.conf"))
+	assert.Equal(t, 200, match.Pid)
+	assert.True(t, match.Ambiguous)
+	assert.Equal(t, []int{200, 201}, match.Candidates)
+
+	match = table.Find(NewProcessMatcher("worker --id=[0-9]"))
+	assert.Equal(t, 300, match.Pid)
+	assert.True(t, match.Ambiguous)
+
+	match = table.Find(NewProcessMatcher("worker --id=(1"))
+	assert.Equal(t, 0, match.Pid)
+	assert.Empty(t, match.Candidates)
+}
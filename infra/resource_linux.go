@@ -0,0 +1,284 @@
+//go:build linux
+// +build linux
+
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 5:29
+ */
+
+package infra
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/fatima-go/fatima-log"
+	"github.com/fatima-go/juno/domain"
+	"github.com/fatima-go/juno/launch"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	cgroupRoot = "/sys/fs/cgroup"
+
+	ioprioClassShift = 13
+	ioprioWhoProcess = 1
+)
+
+// PrepareLaunchResource cgroup 이 지정되어 있다면 cgroup 을 준비하고 clone 시점에 child 가 cgroup 에 들어가도록 설정한다
+// 리턴되는 함수는 cmd.Start() 이후 반드시 호출되어야 한다 (cgroup fd close)
+func PrepareLaunchResource(cmd *exec.Cmd, spec domain.ResourceSpec) (func(), error) {
+	if len(spec.Cgroup) == 0 {
+		return func() {}, nil
+	}
+
+	dir, err := ensureCgroup(spec)
+	if err != nil {
+		return func() {}, err
+	}
+
+	fd, err := unix.Open(dir, unix.O_PATH|unix.O_DIRECTORY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		return func() {}, fmt.Errorf("fail to open cgroup %s : %s", dir, err.Error())
+	}
+
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	cmd.SysProcAttr.UseCgroupFD = true
+	cmd.SysProcAttr.CgroupFD = fd
+	return func() { _ = unix.Close(fd) }, nil
+}
+
+func ensureCgroup(spec domain.ResourceSpec) (string, error) {
+	dir := filepath.Join(cgroupRoot, filepath.Clean("/"+spec.Cgroup))
+	if _, err := os.Stat(filepath.Join(cgroupRoot, "cgroup.controllers")); err != nil {
+		return "", fmt.Errorf("cgroup v2 not available : %s", err.Error())
+	}
+
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		return "", fmt.Errorf("fail to make cgroup %s : %s", dir, err.Error())
+	}
+
+	// enable controllers from root to parent (best effort)
+	parents := make([]string, 0)
+	for p := filepath.Dir(dir); strings.HasPrefix(p, cgroupRoot); p = filepath.Dir(p) {
+		parents = append([]string{p}, parents...)
+		if p == cgroupRoot {
+			break
+		}
+	}
+	for _, p := range parents {
+		enableCgroupControllers(p)
+	}
+
+	if len(spec.MemoryMax) > 0 {
+		err = writeCgroupValue(dir, "memory.max", spec.MemoryMax)
+		if err != nil {
+			return "", err
+		}
+	}
+	if len(spec.CpuMax) > 0 {
+		err = writeCgroupValue(dir, "cpu.max", spec.CpuMax)
+		if err != nil {
+			return "", err
+		}
+	}
+	return dir, nil
+}
+
+func enableCgroupControllers(dir string) {
+	for _, controller := range []string{"+memory", "+cpu"} {
+		err := os.WriteFile(filepath.Join(dir, "cgroup.subtree_control"), []byte(controller), 0644)
+		if err != nil {
+			log.Debug("fail to enable %s on %s : %s", controller, dir, err.Error())
+		}
+	}
+}
+
+func writeCgroupValue(dir, name, value string) error {
+	err := os.WriteFile(filepath.Join(dir, name), []byte(value), 0644)
+	if err != nil {
+		return fmt.Errorf("fail to write %s=%s on %s : %s", name, value, dir, err.Error())
+	}
+	return nil
+}
+
+// buildHelperResource rlimit, nice, ionice, cpu affinity 를 launch helper 가 적용할 값으로 변환한다
+// nice, ionice, affinity 는 thread 단위이므로 helper 가 exec 직전에 자기 자신에게 적용한다
+func buildHelperResource(spec domain.ResourceSpec, helper *launch.Spec) error {
+	limits := []struct {
+		resource int
+		name     string
+		value    *int64
+	}{
+		{unix.RLIMIT_NOFILE, "nofile", spec.RlimitNoFile},
+		{unix.RLIMIT_CORE, "core", spec.RlimitCore},
+		{unix.RLIMIT_AS, "as", spec.RlimitAS},
+	}
+	for _, l := range limits {
+		if l.value == nil {
+			continue
+		}
+		value := uint64(unix.RLIM_INFINITY)
+		if *l.value != domain.RlimitUnlimited {
+			value = uint64(*l.value)
+		}
+		helper.Rlimits = append(helper.Rlimits, launch.Rlimit{Name: l.name, Resource: l.resource, Value: value})
+	}
+
+	helper.Nice = spec.Nice
+
+	if len(spec.IoniceClass) > 0 {
+		prio := ioniceClassValue(spec.IoniceClass)<<ioprioClassShift | spec.IoniceLevel
+		helper.Ioprio = &prio
+	}
+
+	if len(spec.CpuAffinity) > 0 {
+		cpus, err := domain.ParseCpuList(spec.CpuAffinity)
+		if err != nil {
+			return fmt.Errorf("cpu affinity : %s", err.Error())
+		}
+		helper.Cpus = cpus
+	}
+	return nil
+}
+
+func ioniceClassValue(class string) int {
+	switch class {
+	case domain.IoniceClassRealtime:
+		return 1
+	case domain.IoniceClassBestEffort:
+		return 2
+	case domain.IoniceClassIdle:
+		return 3
+	}
+	return 0
+}
+
+// ReadProcessResource 프로세스에 실제 적용된 리소스 정보를 /proc 과 cgroup 에서 읽는다
+func ReadProcessResource(pid int) domain.ResourceStatus {
+	status := domain.ResourceStatus{}
+	if pid < 1 {
+		return status
+	}
+
+	for _, l := range []struct {
+		resource int
+		target   *string
+	}{
+		{unix.RLIMIT_NOFILE, &status.RlimitNoFile},
+		{unix.RLIMIT_CORE, &status.RlimitCore},
+		{unix.RLIMIT_AS, &status.RlimitAS},
+	} {
+		limit := unix.Rlimit{}
+		if err := unix.Prlimit(pid, l.resource, nil, &limit); err == nil {
+			*l.target = formatRlimit(limit)
+		}
+	}
+
+	if prio, err := unix.Getpriority(unix.PRIO_PROCESS, pid); err == nil {
+		// getpriority syscall returns 20 - nice
+		status.Nice = strconv.Itoa(20 - prio)
+	}
+
+	r, _, e := unix.Syscall(unix.SYS_IOPRIO_GET, ioprioWhoProcess, uintptr(pid), 0)
+	if e == 0 {
+		status.Ionice = formatIoprio(int(r))
+	}
+
+	set := unix.CPUSet{}
+	if err := unix.SchedGetaffinity(pid, &set); err == nil {
+		status.CpuAffinity = formatCpuSet(set)
+	}
+
+	b, err := os.ReadFile(fmt.Sprintf("/proc/%d/cgroup", pid))
+	if err == nil {
+		for _, line := range strings.Split(strings.TrimSpace(string(b)), "\n") {
+			if strings.HasPrefix(line, "0::") {
+				status.Cgroup = strings.TrimPrefix(line, "0::")
+				break
+			}
+		}
+	}
+	if len(status.Cgroup) > 0 {
+		dir := filepath.Join(cgroupRoot, status.Cgroup)
+		status.MemoryMax = readCgroupValue(dir, "memory.max")
+		status.CpuMax = readCgroupValue(dir, "cpu.max")
+	}
+
+	return status
+}
+
+func readCgroupValue(dir, name string) string {
+	b, err := os.ReadFile(filepath.Join(dir, name))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(b))
+}
+
+func formatRlimit(limit unix.Rlimit) string {
+	format := func(v uint64) string {
+		if v == unix.RLIM_INFINITY {
+			return "unlimited"
+		}
+		return strconv.FormatUint(v, 10)
+	}
+	return fmt.Sprintf("%s/%s", format(limit.Cur), format(limit.Max))
+}
+
+func formatIoprio(prio int) string {
+	class := prio >> ioprioClassShift
+	level := prio & ((1 << ioprioClassShift) - 1)
+	switch class {
+	case 1:
+		return fmt.Sprintf("%s:%d", domain.IoniceClassRealtime, level)
+	case 2:
+		return fmt.Sprintf("%s:%d", domain.IoniceClassBestEffort, level)
+	case 3:
+		return domain.IoniceClassIdle
+	}
+	return "none"
+}
+
+func formatCpuSet(set unix.CPUSet) string {
+	ranges := make([]string, 0)
+	start := -1
+	size := len(set) * 64
+	for i := 0; i <= size; i++ {
+		on := i < size && set.IsSet(i)
+		if on && start < 0 {
+			start = i
+		} else if !on && start >= 0 {
+			if start == i-1 {
+				ranges = append(ranges, strconv.Itoa(start))
+			} else {
+				ranges = append(ranges, fmt.Sprintf("%d-%d", start, i-1))
+			}
+			start = -1
+		}
+	}
+	return strings.Join(ranges, ",")
+}
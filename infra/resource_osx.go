@@ -0,0 +1,49 @@
+//go:build darwin
+// +build darwin
+
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 5:29
+ */
+
+package infra
+
+import (
+	"fmt"
+	"os/exec"
+
+	"github.com/fatima-go/juno/domain"
+	"github.com/fatima-go/juno/launch"
+)
+
+// PrepareLaunchResource DARWIN not support cgroup
+func PrepareLaunchResource(cmd *exec.Cmd, spec domain.ResourceSpec) (func(), error) {
+	if len(spec.Cgroup) > 0 {
+		return func() {}, fmt.Errorf("cgroup is not supported on darwin")
+	}
+	return func() {}, nil
+}
+
+// buildHelperResource DARWIN not support at this time. 기동을 거부한다
+func buildHelperResource(spec domain.ResourceSpec, helper *launch.Spec) error {
+	return fmt.Errorf("resource spec is not supported on darwin")
+}
+
+func ReadProcessResource(pid int) domain.ResourceStatus {
+	return domain.ResourceStatus{}
+}
@@ -23,6 +23,8 @@ package main
 import (
 	"github.com/fatima-go/fatima-core/runtime"
 	"github.com/fatima-go/juno/engine"
+	// launch helper 로 실행된 경우 fatima-core 가 초기화되기 전에 target 을 exec 한다
+	_ "github.com/fatima-go/juno/launch"
 )
 
 func main() {
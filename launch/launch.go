@@ -0,0 +1,139 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 7:12
+ */
+
+// Package launch juno 가 child 를 기동할 때 umask, rlimit, nice 등 process/thread 단위 속성을 child 에게만 적용하기 위해
+// juno 자신을 helper 로 다시 실행한다. helper 는 이 패키지의 init 에서 속성을 적용한 후 target 을 exec 한다.
+//
+// fatima-core builder 패키지의 init 은 단일 인스턴스 검사로 helper 를 종료시키므로 helper 는 그보다 먼저 동작해야 한다.
+// 패키지는 import path 순서로, 의존 패키지가 모두 초기화된 것부터 초기화되므로 이 패키지는 builder 가 (grpc 를 통해)
+// 이미 의존하는 표준 라이브러리만 import 해야 한다. fatima-core, juno 의 다른 패키지를 import 하지 않는다
+package launch
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"runtime"
+	"strings"
+	"syscall"
+)
+
+const (
+	// HelperArg juno 가 자기 자신을 launch helper 로 실행할 때 사용하는 첫번째 인자
+	HelperArg = "__juno_launch__"
+	// SpecEnv helper 에게 Spec 을 전달하는 환경변수. target 에게는 전달되지 않는다
+	SpecEnv = "JUNO_LAUNCH_SPEC"
+	// ReadyMessage helper 가 exec 직전에 status pipe 에 쓰는 메시지. 이후 exec 이 성공하면 pipe 가 닫힌다 (CLOEXEC)
+	ReadyMessage = "exec"
+	// helper 가 target 을 exec 하지 못한 경우의 종료 코드 (shell 의 command not found 와 동일)
+	failCode = 127
+)
+
+// Spec helper 가 exec 직전에 자기 자신에게 적용할 속성
+type Spec struct {
+	Umask   *int     `json:"umask,omitempty"`
+	Rlimits []Rlimit `json:"rlimits,omitempty"`
+	Nice    *int     `json:"nice,omitempty"`
+	Ioprio  *int     `json:"ioprio,omitempty"` // class<<13 | level
+	Cpus    []int    `json:"cpus,omitempty"`
+	// rlimit 상향, 음수 nice 등은 권한이 필요하므로 helper 가 속성을 적용한 후 사용자를 변경한다
+	Credential *syscall.Credential `json:"credential,omitempty"`
+	StatusFd   int                 `json:"status_fd"`
+}
+
+type Rlimit struct {
+	Name     string `json:"name"`
+	Resource int    `json:"resource"`
+	Value    uint64 `json:"value"` // soft, hard 모두 적용
+}
+
+func (s Spec) IsEmpty() bool {
+	return s.Umask == nil && len(s.Rlimits) == 0 && s.Nice == nil && s.Ioprio == nil && len(s.Cpus) == 0
+}
+
+func init() {
+	if len(os.Args) > 3 && os.Args[1] == HelperArg {
+		run()
+	}
+}
+
+// run 속성을 적용한 후 target 을 exec 한다. 리턴하지 않는다
+// args : juno __juno_launch__ <target path> <target argv...>
+func run() {
+	// nice, affinity 등 thread 단위 속성은 exec 하는 thread 에 적용되어야 한다
+	runtime.LockOSThread()
+
+	spec := Spec{StatusFd: -1}
+	env := make([]string, 0, len(os.Environ()))
+	for _, kv := range os.Environ() {
+		if strings.HasPrefix(kv, SpecEnv+"=") {
+			if err := json.Unmarshal([]byte(kv[len(SpecEnv)+1:]), &spec); err != nil {
+				exit(nil, "invalid launch spec : %s", err.Error())
+			}
+			continue
+		}
+		env = append(env, kv)
+	}
+
+	var status *os.File
+	if spec.StatusFd > 2 {
+		syscall.CloseOnExec(spec.StatusFd)
+		status = os.NewFile(uintptr(spec.StatusFd), "status")
+	}
+
+	if spec.Umask != nil {
+		syscall.Umask(*spec.Umask)
+	}
+	if err := applyResource(spec); err != nil {
+		exit(status, "fail to apply resource : %s", err.Error())
+	}
+	if err := switchCredential(spec.Credential); err != nil {
+		exit(status, "fail to switch credential : %s", err.Error())
+	}
+
+	target := os.Args[2]
+	if status != nil {
+		_, _ = status.WriteString(ReadyMessage)
+	}
+	err := syscall.Exec(target, os.Args[3:], env)
+	exit(status, "fail to exec %s : %s", target, err.Error())
+}
+
+func exit(status *os.File, format string, args ...interface{}) {
+	msg := fmt.Sprintf(format, args...)
+	fmt.Fprintf(os.Stderr, "juno launch helper : %s\n", msg)
+	if status != nil {
+		_, _ = status.WriteString("\n" + msg)
+	}
+	os.Exit(failCode)
+}
+
+// ParseStatus helper 가 status pipe 에 남긴 내용으로 exec 여부를 판단한다.
+// exec 직전의 ReadyMessage 만 있어야 성공이며, 아무것도 없다면 helper 가 속성 적용 전에 종료된 것이다
+func ParseStatus(msg string) error {
+	if msg == ReadyMessage {
+		return nil
+	}
+	msg = strings.TrimSpace(strings.TrimPrefix(msg, ReadyMessage))
+	if len(msg) == 0 {
+		return fmt.Errorf("launch helper exited without exec")
+	}
+	return fmt.Errorf("launch helper : %s", msg)
+}
@@ -0,0 +1,109 @@
+//go:build linux
+// +build linux
+
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 7:12
+ */
+
+package launch
+
+import (
+	"errors"
+	"fmt"
+	"syscall"
+	"unsafe"
+)
+
+const ioprioWhoProcess = 1
+
+// applyResource rlimit, nice, ionice, cpu affinity 를 helper 자신(호출한 thread)에게 적용한다
+func applyResource(spec Spec) error {
+	errs := make([]error, 0)
+	for _, l := range spec.Rlimits {
+		limit := syscall.Rlimit{Cur: l.Value, Max: l.Value}
+		if err := syscall.Setrlimit(l.Resource, &limit); err != nil {
+			errs = append(errs, fmt.Errorf("rlimit %s : %s", l.Name, err.Error()))
+		}
+	}
+
+	if spec.Nice != nil {
+		// pid 0 은 호출한 thread 자신
+		if err := syscall.Setpriority(syscall.PRIO_PROCESS, 0, *spec.Nice); err != nil {
+			errs = append(errs, fmt.Errorf("nice : %s", err.Error()))
+		}
+	}
+
+	if spec.Ioprio != nil {
+		_, _, e := syscall.Syscall(syscall.SYS_IOPRIO_SET, ioprioWhoProcess, 0, uintptr(*spec.Ioprio))
+		if e != 0 {
+			errs = append(errs, fmt.Errorf("ionice : %s", e.Error()))
+		}
+	}
+
+	if len(spec.Cpus) > 0 {
+		if err := setAffinity(spec.Cpus); err != nil {
+			errs = append(errs, fmt.Errorf("cpu affinity : %s", err.Error()))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func setAffinity(cpus []int) error {
+	max := 0
+	for _, cpu := range cpus {
+		if cpu < 0 {
+			return fmt.Errorf("invalid cpu %d", cpu)
+		}
+		if cpu > max {
+			max = cpu
+		}
+	}
+	mask := make([]uint64, max/64+1)
+	for _, cpu := range cpus {
+		mask[cpu/64] |= 1 << (uint(cpu) % 64)
+	}
+	_, _, e := syscall.RawSyscall(syscall.SYS_SCHED_SETAFFINITY, 0, uintptr(len(mask)*8), uintptr(unsafe.Pointer(&mask[0])))
+	if e != 0 {
+		return e
+	}
+	return nil
+}
+
+// switchCredential group, uid 를 변경한다. 속성 적용 후 exec 직전에 호출한다
+func switchCredential(c *syscall.Credential) error {
+	if c == nil {
+		return nil
+	}
+	if !c.NoSetGroups {
+		groups := make([]int, 0, len(c.Groups))
+		for _, g := range c.Groups {
+			groups = append(groups, int(g))
+		}
+		if err := syscall.Setgroups(groups); err != nil {
+			return fmt.Errorf("setgroups : %s", err.Error())
+		}
+	}
+	if err := syscall.Setresgid(int(c.Gid), int(c.Gid), int(c.Gid)); err != nil {
+		return fmt.Errorf("setgid %d : %s", c.Gid, err.Error())
+	}
+	if err := syscall.Setresuid(int(c.Uid), int(c.Uid), int(c.Uid)); err != nil {
+		return fmt.Errorf("setuid %d : %s", c.Uid, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+//go:build darwin
+// +build darwin
+
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 7:12
+ */
+
+package launch
+
+import (
+	"fmt"
+	"syscall"
+)
+
+// applyResource DARWIN not support at this time. 기동을 거부한다
+func applyResource(spec Spec) error {
+	if len(spec.Rlimits) == 0 && spec.Nice == nil && spec.Ioprio == nil && len(spec.Cpus) == 0 {
+		return nil
+	}
+	return fmt.Errorf("resource spec is not supported on darwin")
+}
+
+// switchCredential DARWIN 은 clone 시점에 사용자를 변경한다
+func switchCredential(c *syscall.Credential) error {
+	return nil
+}
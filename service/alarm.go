@@ -0,0 +1,381 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 6:10
+ */
+
+package service
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/fatima-go/fatima-core"
+	"github.com/fatima-go/fatima-core/monitor"
+	"github.com/fatima-go/fatima-log"
+	"github.com/fatima-go/juno/domain"
+)
+
+const (
+	alarmDataDir    = "alarm"
+	alarmConfigFile = "config.json"
+	// sink 별 대기 알람 수. 넘치면 버린다
+	alarmQueueSize          = 256
+	defaultAlarmSinkTimeout = 5 * time.Second
+)
+
+// defaultAlarmConfig 설정 파일이 없으면 기존과 같이 SystemNotifyHandler 로만 전달한다
+var defaultAlarmConfig = domain.AlarmConfig{
+	Sinks: []domain.AlarmSinkConfig{{Name: "notify", Type: domain.AlarmSinkNotify}},
+}
+
+// alarmSink 알람을 실제로 전달하는 대상. text 는 template 이 없으면 빈 문자열
+type alarmSink interface {
+	send(alarm domain.Alarm, text string) error
+	close()
+}
+
+type alarmRouter struct {
+	fatimaRuntime fatima.FatimaRuntime
+	mutex         sync.Mutex
+	config        domain.AlarmConfig
+	runners       []*alarmSinkRunner
+}
+
+var alarms *alarmRouter
+
+func newAlarmRouter(fatimaRuntime fatima.FatimaRuntime) *alarmRouter {
+	router := &alarmRouter{fatimaRuntime: fatimaRuntime}
+	config, err := loadAlarmConfigFile(buildAlarmConfigFile(fatimaRuntime.GetEnv()))
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Warn("invalid alarm config. use default : %s", err.Error())
+		}
+		config = defaultAlarmConfig
+	}
+	router.apply(config)
+	return router
+}
+
+func buildAlarmConfigFile(env fatima.FatimaEnv) string {
+	return filepath.Join(env.GetFolderGuide().GetDataFolder(), alarmDataDir, alarmConfigFile)
+}
+
+func loadAlarmConfigFile(file string) (domain.AlarmConfig, error) {
+	config := domain.AlarmConfig{}
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return config, err
+	}
+	err = json.Unmarshal(b, &config)
+	if err != nil {
+		return config, err
+	}
+	return config, config.Validate()
+}
+
+// apply sink 들을 새로 구성한다. 기존 sink 는 대기중인 알람을 모두 전달한 후 닫힌다
+func (r *alarmRouter) apply(config domain.AlarmConfig) {
+	runners := make([]*alarmSinkRunner, 0, len(config.Sinks))
+	for _, c := range config.Sinks {
+		// 설정 파일을 직접 수정한 경우에도 허용되지 않은 경로는 사용하지 않는다
+		c, err := resolveAlarmSinkPath(r.fatimaRuntime.GetEnv(), c)
+		if err != nil {
+			log.Warn("skip alarm sink %s : %s", c.Name, err.Error())
+			continue
+		}
+		runner, err := newAlarmSinkRunner(r.fatimaRuntime, c)
+		if err != nil {
+			log.Warn("fail to prepare alarm sink %s : %s", c.Name, err.Error())
+			continue
+		}
+		runners = append(runners, runner)
+	}
+
+	r.mutex.Lock()
+	old := r.runners
+	r.config = config
+	r.runners = runners
+	r.mutex.Unlock()
+
+	for _, runner := range old {
+		close(runner.queue)
+	}
+	log.Info("alarm sinks : %d", len(runners))
+}
+
+func (r *alarmRouter) dispatch(alarm domain.Alarm) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	for _, runner := range r.runners {
+		if !runner.config.Match(alarm) {
+			continue
+		}
+		select {
+		case runner.queue <- alarm:
+		default:
+			log.Warn("alarm sink %s queue is full. drop alarm : %s", runner.config.Name, alarm.Message)
+			runner.countFailure(fmt.Errorf("queue is full"))
+		}
+	}
+}
+
+func (r *alarmRouter) status() []domain.AlarmSinkStatus {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	list := make([]domain.AlarmSinkStatus, 0, len(r.runners))
+	for _, runner := range r.runners {
+		list = append(list, runner.getStatus())
+	}
+	return list
+}
+
+// raiseAlarm 알람을 routing 조건에 맞는 모든 sink 로 비동기 전달한다
+// monMutex 를 잡은 상태에서도 호출되므로 block 되지 않아야 한다
+func raiseAlarm(level monitor.AlarmLevel, category, event, proc, message string) {
+	if alarms == nil {
+		log.Warn("[%s] %s", level, message)
+		return
+	}
+
+	alarm := domain.Alarm{
+		Time:     time.Now().UnixMilli(),
+		Level:    level.String(),
+		Category: category,
+		Event:    event,
+		Process:  proc,
+		Message:  message,
+		Package:  alarms.fatimaRuntime.GetPackaging().GetName(),
+		Host:     alarms.fatimaRuntime.GetPackaging().GetHost(),
+	}
+	alarms.dispatch(alarm)
+}
+
+// alarmSinkRunner sink 별로 순서대로 전달하며 rate limit 과 template 을 적용한다
+type alarmSinkRunner struct {
+	config     domain.AlarmSinkConfig
+	sink       alarmSink
+	tmpl       *template.Template
+	queue      chan domain.Alarm
+	mutex      sync.Mutex
+	sent       []int64 // rate period 안의 전달 시각 (unix millis)
+	suppressed int
+	stat       domain.AlarmSinkStatus
+}
+
+func newAlarmSinkRunner(fatimaRuntime fatima.FatimaRuntime, config domain.AlarmSinkConfig) (*alarmSinkRunner, error) {
+	sink, err := newAlarmSink(fatimaRuntime, config)
+	if err != nil {
+		return nil, err
+	}
+
+	runner := &alarmSinkRunner{config: config, sink: sink}
+	runner.stat = domain.AlarmSinkStatus{Name: config.Name, Type: config.Type}
+	if len(config.Template) > 0 {
+		runner.tmpl, err = template.New(config.Name).Parse(config.Template)
+		if err != nil {
+			return nil, err
+		}
+	}
+	runner.queue = make(chan domain.Alarm, alarmQueueSize)
+	go runner.run()
+	return runner, nil
+}
+
+func (s *alarmSinkRunner) run() {
+	defer s.sink.close()
+	for alarm := range s.queue {
+		s.deliver(alarm, time.Now().UnixMilli())
+	}
+}
+
+func (s *alarmSinkRunner) deliver(alarm domain.Alarm, now int64) {
+	if !s.allow(now) {
+		log.Info("alarm sink %s rate limited : %s", s.config.Name, alarm.Message)
+		return
+	}
+
+	s.mutex.Lock()
+	alarm.Suppressed = s.suppressed
+	s.suppressed = 0
+	s.mutex.Unlock()
+
+	text, err := s.render(alarm)
+	if err == nil {
+		err = s.sink.send(alarm, text)
+	}
+	if err != nil {
+		log.Warn("fail to send alarm to %s : %s", s.config.Name, err.Error())
+		s.countFailure(err)
+		return
+	}
+
+	s.mutex.Lock()
+	s.stat.Sent++
+	s.mutex.Unlock()
+}
+
+// allow rate limit 안이라면 전달 시각을 기록하고 true
+func (s *alarmSinkRunner) allow(now int64) bool {
+	if s.config.RateLimit <= 0 {
+		return true
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	limit := now - int64(s.config.RatePeriodSec)*1000
+	kept := s.sent[:0]
+	for _, t := range s.sent {
+		if t > limit {
+			kept = append(kept, t)
+		}
+	}
+	s.sent = kept
+	if len(s.sent) >= s.config.RateLimit {
+		s.suppressed++
+		s.stat.RateLimited++
+		return false
+	}
+	s.sent = append(s.sent, now)
+	return true
+}
+
+func (s *alarmSinkRunner) render(alarm domain.Alarm) (string, error) {
+	if s.tmpl == nil {
+		return "", nil
+	}
+	var buf bytes.Buffer
+	err := s.tmpl.Execute(&buf, alarm)
+	if err != nil {
+		return "", fmt.Errorf("fail to render template : %s", err.Error())
+	}
+	return buf.String(), nil
+}
+
+func (s *alarmSinkRunner) countFailure(err error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.stat.Failed++
+	s.stat.LastError = err.Error()
+}
+
+func (s *alarmSinkRunner) getStatus() domain.AlarmSinkStatus {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.stat
+}
+
+// formatAlarmText template 이 없을 때의 기본 메시지
+func formatAlarmText(alarm domain.Alarm) string {
+	if alarm.Suppressed > 0 {
+		return fmt.Sprintf("%s\n(rate limit 으로 생략된 알람 %d건)", alarm.Message, alarm.Suppressed)
+	}
+	return alarm.Message
+}
+
+func (service *DomainService) GetAlarmConfig() (domain.AlarmConfig, []domain.AlarmSinkStatus) {
+	if alarms == nil {
+		return defaultAlarmConfig, []domain.AlarmSinkStatus{}
+	}
+	alarms.mutex.Lock()
+	config := alarms.config
+	alarms.mutex.Unlock()
+	return config.Masked(), alarms.status()
+}
+
+// UpdateAlarmConfig config 가 nil 이면 설정 파일을 삭제하고 기본 설정을 따른다
+func (service *DomainService) UpdateAlarmConfig(config *domain.AlarmConfig) error {
+	if alarms == nil {
+		return fmt.Errorf("alarm router is not prepared")
+	}
+	if config != nil {
+		log.Info("UpdateAlarmConfig. config=[%v]", config.Masked())
+	} else {
+		log.Info("UpdateAlarmConfig. config=[nil]")
+	}
+
+	file := buildAlarmConfigFile(service.fatimaRuntime.GetEnv())
+	if config == nil {
+		err := os.Remove(file)
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		alarms.apply(defaultAlarmConfig)
+		return nil
+	}
+
+	err := config.Validate()
+	if err != nil {
+		return err
+	}
+	env := service.fatimaRuntime.GetEnv()
+	for _, sink := range config.Sinks {
+		if _, err = resolveAlarmSinkPath(env, sink); err != nil {
+			return err
+		}
+	}
+
+	// 조회 결과를 그대로 저장하는 경우 가려진 header 는 현재 값을 유지한다
+	alarms.mutex.Lock()
+	config.RestoreMasked(alarms.config)
+	alarms.mutex.Unlock()
+	if config.Sinks == nil {
+		config.Sinks = []domain.AlarmSinkConfig{}
+	}
+
+	err = os.MkdirAll(filepath.Dir(file), 0755)
+	if err != nil {
+		return fmt.Errorf("fail to make dir %s : %s", filepath.Dir(file), err.Error())
+	}
+	b, err := json.MarshalIndent(config, "", "  ")
+	if err != nil {
+		return err
+	}
+	err = os.WriteFile(file, b, 0644)
+	if err != nil {
+		return err
+	}
+	alarms.apply(*config)
+	return nil
+}
+
+// SendTestAlarm sink 설정 확인을 위한 test 알람
+func (service *DomainService) SendTestAlarm(level string, message string) error {
+	var alarmLvl monitor.AlarmLevel
+	switch strings.ToUpper(level) {
+	case "", "WARN":
+		alarmLvl = monitor.AlarmLevelWarn
+	case "MINOR":
+		alarmLvl = monitor.AlarmLevelMinor
+	case "MAJOR":
+		alarmLvl = monitor.AlamLevelMajor
+	default:
+		return fmt.Errorf("invalid alarm level : %s", level)
+	}
+	if len(message) == 0 {
+		message = "juno test alarm"
+	}
+	raiseAlarm(alarmLvl, domain.AlarmEventTest, domain.AlarmEventTest, "", message)
+	return nil
+}
@@ -0,0 +1,345 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 6:10
+ */
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/syslog"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/fatima-go/fatima-core"
+	"github.com/fatima-go/fatima-core/monitor"
+	"github.com/fatima-go/juno/domain"
+)
+
+const (
+	// command sink 는 <FATIMA_HOME>/app/juno/alarm 폴더의 실행 파일만 허용한다
+	alarmCommandFolder = "alarm"
+	// file sink 는 <data>/alarm/sink 아래에만 기록한다
+	alarmFileSinkDir = "sink"
+)
+
+func buildAlarmCommandDir(env fatima.FatimaEnv) string {
+	return filepath.Join(getAppDir(env, env.GetSystemProc().GetProgramName()), alarmCommandFolder)
+}
+
+func buildAlarmFileSinkDir(env fatima.FatimaEnv) string {
+	return filepath.Join(env.GetFolderGuide().GetDataFolder(), alarmDataDir, alarmFileSinkDir)
+}
+
+// resolveAlarmCommand command 의 실제 경로가 허용된 폴더 안에 있는지 확인한다
+func resolveAlarmCommand(dir string, command string) (string, error) {
+	realDir, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		return "", fmt.Errorf("invalid alarm command dir : %s", err.Error())
+	}
+	realPath, err := filepath.EvalSymlinks(command)
+	if err != nil {
+		return "", fmt.Errorf("invalid alarm command : %s", err.Error())
+	}
+	if filepath.Dir(realPath) != realDir {
+		return "", fmt.Errorf("alarm command %s is not in %s", command, dir)
+	}
+	return realPath, nil
+}
+
+// resolveAlarmFilePath file sink 의 실제 경로(symlink 를 따라간 경로)가 허용된 폴더 아래에 있는지 확인한다
+func resolveAlarmFilePath(dir string, path string) (string, error) {
+	clean := filepath.Clean(path)
+	if !isUnderDir(dir, clean) {
+		return "", fmt.Errorf("alarm file %s is not under %s", path, dir)
+	}
+
+	realDir, err := evalExistingPath(dir)
+	if err != nil {
+		return "", fmt.Errorf("invalid alarm file dir : %s", err.Error())
+	}
+	realPath, err := evalExistingPath(clean)
+	if err != nil {
+		return "", fmt.Errorf("invalid alarm file : %s", err.Error())
+	}
+	if !isUnderDir(realDir, realPath) {
+		return "", fmt.Errorf("alarm file %s is not under %s", path, dir)
+	}
+	return realPath, nil
+}
+
+func isUnderDir(dir string, path string) bool {
+	rel, err := filepath.Rel(dir, path)
+	return err == nil && rel != "." && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// evalExistingPath 존재하는 가장 깊은 상위 경로까지 symlink 를 따라가고 나머지는 그대로 붙인다
+func evalExistingPath(path string) (string, error) {
+	rest := ""
+	for {
+		real, err := filepath.EvalSymlinks(path)
+		if err == nil {
+			return filepath.Join(real, rest), nil
+		}
+		if !os.IsNotExist(err) {
+			return "", err
+		}
+		if _, e := os.Lstat(path); e == nil {
+			return "", fmt.Errorf("%s is dangling symlink", path)
+		}
+		parent := filepath.Dir(path)
+		if parent == path {
+			return "", err
+		}
+		rest = filepath.Join(filepath.Base(path), rest)
+		path = parent
+	}
+}
+
+// resolveAlarmSinkPath command, file sink 가 허용된 경로만 사용하는지 확인하고 실제 경로로 바꾼 설정을 리턴한다
+func resolveAlarmSinkPath(env fatima.FatimaEnv, config domain.AlarmSinkConfig) (domain.AlarmSinkConfig, error) {
+	switch config.Type {
+	case domain.AlarmSinkFile:
+		path, err := resolveAlarmFilePath(buildAlarmFileSinkDir(env), config.Path)
+		if err != nil {
+			return config, fmt.Errorf("[%s] %s", config.Name, err.Error())
+		}
+		config.Path = path
+	case domain.AlarmSinkCommand:
+		command, err := resolveAlarmCommand(buildAlarmCommandDir(env), config.Command)
+		if err != nil {
+			return config, fmt.Errorf("[%s] %s", config.Name, err.Error())
+		}
+		config.Command = command
+	}
+	return config, nil
+}
+
+func newAlarmSink(fatimaRuntime fatima.FatimaRuntime, config domain.AlarmSinkConfig) (alarmSink, error) {
+	timeout := defaultAlarmSinkTimeout
+	if config.TimeoutSec > 0 {
+		timeout = time.Duration(config.TimeoutSec) * time.Second
+	}
+
+	switch config.Type {
+	case domain.AlarmSinkNotify:
+		return &notifyAlarmSink{handler: fatimaRuntime.GetSystemNotifyHandler()}, nil
+	case domain.AlarmSinkWebhook:
+		return &webhookAlarmSink{url: config.Url, headers: config.Headers, client: &http.Client{Timeout: timeout}}, nil
+	case domain.AlarmSinkFile:
+		return &fileAlarmSink{path: config.Path}, nil
+	case domain.AlarmSinkSyslog:
+		tag := config.Tag
+		if len(tag) == 0 {
+			tag = "juno"
+		}
+		return &syslogAlarmSink{tag: tag}, nil
+	case domain.AlarmSinkCommand:
+		return &commandAlarmSink{command: config.Command, args: config.Args, timeout: timeout}, nil
+	}
+	return nil, fmt.Errorf("invalid alarm sink type : %s", config.Type)
+}
+
+func toMonitorAlarmLevel(level string) monitor.AlarmLevel {
+	switch level {
+	case "MAJOR":
+		return monitor.AlamLevelMajor
+	case "MINOR":
+		return monitor.AlarmLevelMinor
+	}
+	return monitor.AlarmLevelWarn
+}
+
+// notifyAlarmSink 기존 SystemNotifyHandler
+type notifyAlarmSink struct {
+	handler monitor.SystemNotifyHandler
+}
+
+func (s *notifyAlarmSink) send(alarm domain.Alarm, text string) error {
+	if len(text) == 0 {
+		text = formatAlarmText(alarm)
+	}
+	s.handler.SendAlarmWithCategory(toMonitorAlarmLevel(alarm.Level), monitor.ActionUnknown, text, alarm.Category)
+	return nil
+}
+
+func (s *notifyAlarmSink) close() {
+}
+
+// webhookAlarmSink template 이 없으면 알람 JSON 을 POST 한다
+type webhookAlarmSink struct {
+	url     string
+	headers map[string]string
+	client  *http.Client
+}
+
+func (s *webhookAlarmSink) send(alarm domain.Alarm, text string) error {
+	body := []byte(text)
+	if len(text) == 0 {
+		b, err := json.Marshal(alarm)
+		if err != nil {
+			return err
+		}
+		body = b
+	}
+
+	req, err := http.NewRequest(http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range s.headers {
+		req.Header.Set(k, v)
+	}
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, 64*1024))
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("webhook response status %d", res.StatusCode)
+	}
+	return nil
+}
+
+func (s *webhookAlarmSink) close() {
+	s.client.CloseIdleConnections()
+}
+
+// fileAlarmSink 한 줄에 알람 하나를 append 한다. 외부 rotation 을 위해 매번 파일을 연다
+type fileAlarmSink struct {
+	path string
+}
+
+func (s *fileAlarmSink) send(alarm domain.Alarm, text string) error {
+	line := strings.ReplaceAll(text, "\n", "\\n")
+	if len(text) == 0 {
+		b, err := json.Marshal(alarm)
+		if err != nil {
+			return err
+		}
+		line = string(b)
+	}
+
+	dir := filepath.Dir(s.path)
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		return err
+	}
+	// path 는 symlink 를 모두 따라간 경로이므로 이후에 만들어진 symlink 는 따라가지 않는다
+	if real, err := filepath.EvalSymlinks(dir); err != nil || real != dir {
+		return fmt.Errorf("alarm file dir %s is changed to symlink", dir)
+	}
+	f, err := os.OpenFile(s.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND|syscall.O_NOFOLLOW, 0644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = f.WriteString(line + "\n")
+	return err
+}
+
+func (s *fileAlarmSink) close() {
+}
+
+// syslogAlarmSink 로컬 syslog. 전송에 실패하면 다음 알람에서 다시 연결한다
+type syslogAlarmSink struct {
+	tag    string
+	writer *syslog.Writer
+}
+
+func (s *syslogAlarmSink) send(alarm domain.Alarm, text string) error {
+	if s.writer == nil {
+		w, err := syslog.New(syslog.LOG_DAEMON|syslog.LOG_NOTICE, s.tag)
+		if err != nil {
+			return err
+		}
+		s.writer = w
+	}
+
+	if len(text) == 0 {
+		text = fmt.Sprintf("[%s] [%s] %s", alarm.Level, alarm.Category, formatAlarmText(alarm))
+	}
+	var err error
+	switch alarm.Level {
+	case "MAJOR":
+		err = s.writer.Err(text)
+	case "MINOR":
+		err = s.writer.Warning(text)
+	default:
+		err = s.writer.Notice(text)
+	}
+	if err != nil {
+		s.close()
+	}
+	return err
+}
+
+func (s *syslogAlarmSink) close() {
+	if s.writer != nil {
+		_ = s.writer.Close()
+		s.writer = nil
+	}
+}
+
+// commandAlarmSink stdin 으로 알람 JSON 을, 환경변수로 주요 필드를 전달하여 실행한다
+type commandAlarmSink struct {
+	command string
+	args    []string
+	timeout time.Duration
+}
+
+func (s *commandAlarmSink) send(alarm domain.Alarm, text string) error {
+	b, err := json.Marshal(alarm)
+	if err != nil {
+		return err
+	}
+	if len(text) == 0 {
+		text = formatAlarmText(alarm)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, s.command, s.args...)
+	cmd.Stdin = bytes.NewReader(b)
+	cmd.Env = append(os.Environ(),
+		"JUNO_ALARM_LEVEL="+alarm.Level,
+		"JUNO_ALARM_CATEGORY="+alarm.Category,
+		"JUNO_ALARM_EVENT="+alarm.Event,
+		"JUNO_ALARM_PROCESS="+alarm.Process,
+		"JUNO_ALARM_TEXT="+text)
+	output, err := cmd.CombinedOutput()
+	if err == nil {
+		return nil
+	}
+	return fmt.Errorf("command failed : %s %s", err.Error(), strings.TrimSpace(string(output)))
+}
+
+func (s *commandAlarmSink) close() {
+}
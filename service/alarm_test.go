@@ -0,0 +1,193 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 6:10
+ */
+
+package service
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/fatima-go/juno/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAlarmSinkMatch(t *testing.T) {
+	config := domain.AlarmSinkConfig{Name: "ops", Type: domain.AlarmSinkNotify,
+		Levels: []string{"major"}, Categories: []string{"monitor"}, Processes: []string{"search*"}}
+	alarm := domain.Alarm{Level: "MAJOR", Category: "monitor", Process: "searchd"}
+	assert.True(t, config.Match(alarm))
+
+	alarm.Level = "WARN"
+	assert.False(t, config.Match(alarm))
+	alarm.Level = "MAJOR"
+	alarm.Process = "order"
+	assert.False(t, config.Match(alarm))
+
+	assert.True(t, domain.AlarmSinkConfig{}.Match(alarm))
+}
+
+func TestAlarmConfigValidate(t *testing.T) {
+	valid := domain.AlarmConfig{Sinks: []domain.AlarmSinkConfig{
+		{Name: "notify", Type: domain.AlarmSinkNotify},
+		{Name: "hook", Type: domain.AlarmSinkWebhook, Url: "http://127.0.0.1:8080/alarm", RateLimit: 1, RatePeriodSec: 10},
+	}}
+	assert.Nil(t, valid.Validate())
+
+	invalids := []domain.AlarmSinkConfig{
+		{Name: "hook", Type: domain.AlarmSinkWebhook, Url: "ftp://host"},
+		{Name: "file", Type: domain.AlarmSinkFile, Path: "relative.log"},
+		{Name: "rate", Type: domain.AlarmSinkNotify, RateLimit: 3},
+		{Name: "tmpl", Type: domain.AlarmSinkNotify, Template: "{{.Level"},
+		{Name: "level", Type: domain.AlarmSinkNotify, Levels: []string{"CRITICAL"}},
+		{Name: "kind", Type: "email"},
+	}
+	for _, sink := range invalids {
+		assert.NotNil(t, domain.AlarmConfig{Sinks: []domain.AlarmSinkConfig{sink}}.Validate(), sink.Name)
+	}
+
+	dup := domain.AlarmConfig{Sinks: []domain.AlarmSinkConfig{valid.Sinks[0], valid.Sinks[0]}}
+	assert.NotNil(t, dup.Validate())
+}
+
+func TestWebhookAlarmSink(t *testing.T) {
+	var mutex sync.Mutex
+	received := make([]string, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		mutex.Lock()
+		received = append(received, string(b))
+		mutex.Unlock()
+		assert.Equal(t, "token", r.Header.Get("X-Auth"))
+	}))
+	defer server.Close()
+
+	config := domain.AlarmSinkConfig{Name: "hook", Type: domain.AlarmSinkWebhook, Url: server.URL,
+		Headers: map[string]string{"X-Auth": "token"}, RateLimit: 2, RatePeriodSec: 60}
+	runner, err := newAlarmSinkRunner(nil, config)
+	assert.Nil(t, err)
+	defer close(runner.queue)
+
+	alarm := domain.Alarm{Level: "MAJOR", Category: "monitor", Event: domain.AlarmEventStatusChanged, Process: "searchd", Message: "DEAD"}
+	runner.deliver(alarm, 1000)
+	runner.deliver(alarm, 2000)
+	runner.deliver(alarm, 3000) // rate limited
+	runner.deliver(alarm, 62000)
+
+	assert.Equal(t, 3, len(received))
+	sent := domain.Alarm{}
+	assert.Nil(t, json.Unmarshal([]byte(received[0]), &sent))
+	assert.Equal(t, "searchd", sent.Process)
+	assert.Nil(t, json.Unmarshal([]byte(received[2]), &sent))
+	assert.Equal(t, 1, sent.Suppressed)
+
+	status := runner.getStatus()
+	assert.Equal(t, int64(3), status.Sent)
+	assert.Equal(t, int64(1), status.RateLimited)
+
+	// 실패 응답은 failed 로 집계한다
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer failing.Close()
+	config.Url = failing.URL
+	config.RateLimit = 0
+	runner2, _ := newAlarmSinkRunner(nil, config)
+	defer close(runner2.queue)
+	runner2.deliver(alarm, 1000)
+	assert.Equal(t, int64(1), runner2.getStatus().Failed)
+}
+
+func TestFileAlarmSinkTemplate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "alarm", "alarm.log")
+	config := domain.AlarmSinkConfig{Name: "file", Type: domain.AlarmSinkFile, Path: path,
+		Template: "{{.Level}} [{{.Process}}] {{.Message}}"}
+	runner, err := newAlarmSinkRunner(nil, config)
+	assert.Nil(t, err)
+	defer close(runner.queue)
+
+	runner.deliver(domain.Alarm{Level: "WARN", Process: "order", Message: "line1\nline2"}, 1000)
+	runner.deliver(domain.Alarm{Level: "MAJOR", Process: "searchd", Message: "DEAD"}, 2000)
+
+	b, err := os.ReadFile(path)
+	assert.Nil(t, err)
+	lines := strings.Split(strings.TrimSpace(string(b)), "\n")
+	assert.Equal(t, []string{"WARN [order] line1\\nline2", "MAJOR [searchd] DEAD"}, lines)
+}
+
+func TestAlarmSinkPath(t *testing.T) {
+	dir := t.TempDir()
+	sinkDir := filepath.Join(dir, "data", "alarm", "sink")
+	_, err := resolveAlarmFilePath(sinkDir, filepath.Join(sinkDir, "ops", "alarm.log"))
+	assert.Nil(t, err)
+	_, err = resolveAlarmFilePath(sinkDir, filepath.Join(sinkDir, "..", "config.json"))
+	assert.NotNil(t, err)
+	_, err = resolveAlarmFilePath(sinkDir, "/etc/cron.d/juno")
+	assert.NotNil(t, err)
+	// sink 폴더 안에서 밖을 가리키는 symlink 를 거치는 경로는 허용하지 않는다
+	assert.Nil(t, os.MkdirAll(sinkDir, 0755))
+	assert.Nil(t, os.Symlink(dir, filepath.Join(sinkDir, "escape")))
+	_, err = resolveAlarmFilePath(sinkDir, filepath.Join(sinkDir, "escape", "config.json"))
+	assert.NotNil(t, err)
+	assert.Nil(t, os.Symlink(filepath.Join(dir, "config.json"), filepath.Join(sinkDir, "link.log")))
+	_, err = resolveAlarmFilePath(sinkDir, filepath.Join(sinkDir, "link.log"))
+	assert.NotNil(t, err)
+	// 검사 이후에 symlink 로 바뀐 파일에는 기록하지 않는다
+	path, err := resolveAlarmFilePath(sinkDir, filepath.Join(sinkDir, "ops.log"))
+	assert.Nil(t, err)
+	assert.Nil(t, os.Symlink(filepath.Join(dir, "config.json"), path))
+	assert.NotNil(t, (&fileAlarmSink{path: path}).send(domain.Alarm{Message: "DEAD"}, ""))
+	_, err = os.Stat(filepath.Join(dir, "config.json"))
+	assert.True(t, os.IsNotExist(err))
+
+	commandDir := filepath.Join(dir, "app", "juno", "alarm")
+	assert.Nil(t, os.MkdirAll(commandDir, 0755))
+	assert.Nil(t, os.WriteFile(filepath.Join(commandDir, "page.sh"), []byte("#!/bin/sh\n"), 0755))
+	assert.Nil(t, os.WriteFile(filepath.Join(dir, "other.sh"), []byte("#!/bin/sh\n"), 0755))
+	assert.Nil(t, os.Symlink(filepath.Join(dir, "other.sh"), filepath.Join(commandDir, "link.sh")))
+
+	_, err = resolveAlarmCommand(commandDir, filepath.Join(commandDir, "page.sh"))
+	assert.Nil(t, err)
+	_, err = resolveAlarmCommand(commandDir, filepath.Join(dir, "other.sh"))
+	assert.NotNil(t, err)
+	// 폴더 밖을 가리키는 symlink 는 허용하지 않는다
+	_, err = resolveAlarmCommand(commandDir, filepath.Join(commandDir, "link.sh"))
+	assert.NotNil(t, err)
+}
+
+func TestAlarmConfigMasked(t *testing.T) {
+	current := domain.AlarmConfig{Sinks: []domain.AlarmSinkConfig{{Name: "ops", Type: domain.AlarmSinkWebhook,
+		Url: "http://10.0.0.5/alarm", Headers: map[string]string{"Authorization": "Bearer secret"}}}}
+	masked := current.Masked()
+	assert.Equal(t, domain.AlarmHeaderMask, masked.Sinks[0].Headers["Authorization"])
+	assert.Equal(t, "Bearer secret", current.Sinks[0].Headers["Authorization"])
+
+	// 조회 결과를 그대로 저장하면 기존 값을 유지하고 새로 입력한 값은 그대로 쓴다
+	masked.Sinks[0].Headers["X-Team"] = "search"
+	masked.RestoreMasked(current)
+	assert.Equal(t, "Bearer secret", masked.Sinks[0].Headers["Authorization"])
+	assert.Equal(t, "search", masked.Sinks[0].Headers["X-Team"])
+}
@@ -41,11 +41,23 @@ var localIpAddress = "0.0.0.0"
 func PrepareService(fatimaRuntime fatima.FatimaRuntime) {
 	inspector = infra.NewSystemInspector(fatimaRuntime)
 	newProcessMonitor(fatimaRuntime)
+	alarms = newAlarmRouter(fatimaRuntime)
 	allow, err := fatimaRuntime.GetConfig().GetBool(remoteOperationAllow)
 	if err == nil {
 		remoteOperationAllowed = allow
 	}
 
+	loadOutputCaptureConfig(fatimaRuntime.GetConfig())
+	loadSignalConfig(fatimaRuntime.GetConfig())
+	loadTrashConfig(fatimaRuntime.GetConfig())
+	loadScriptConfig(fatimaRuntime.GetConfig())
+	loadRestartConfig(fatimaRuntime.GetConfig())
+	loadMonitorConfig(fatimaRuntime.GetConfig())
+	loadThresholdConfig(fatimaRuntime.GetConfig())
+	loadFlapConfig(fatimaRuntime.GetConfig())
+	loadHeartbeatConfig(fatimaRuntime.GetConfig())
+	loadJournalConfig(fatimaRuntime.GetConfig())
+
 	localIpAddress = getDefaultIpAddress()
 	v, ok := fatimaRuntime.GetConfig().GetValue(domain.PropWebServerAddress)
 	if ok {
@@ -0,0 +1,266 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 5:33
+ */
+
+package service
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"os/user"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/fatima-go/fatima-core"
+	"github.com/fatima-go/fatima-core/builder"
+	"github.com/fatima-go/juno/domain"
+)
+
+const (
+	accessRead    = 4
+	accessWrite   = 2
+	accessExecute = 1
+)
+
+// LaunchCredential launch spec 의 user/group 을 해석한 결과
+type LaunchCredential struct {
+	Username string
+	HomeDir  string
+	Uid      uint32
+	Gid      uint32
+	Groups   []uint32
+}
+
+// resolveLaunchCredential user/group 이 지정되지 않았다면 nil 을 리턴한다
+func resolveLaunchCredential(spec domain.LaunchSpec) (*LaunchCredential, error) {
+	if len(spec.User) == 0 && len(spec.Group) == 0 {
+		return nil, nil
+	}
+
+	var u *user.User
+	var err error
+	if len(spec.User) > 0 {
+		u, err = lookupUser(spec.User)
+	} else {
+		u, err = user.Current()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unknown user %s : %s", spec.User, err.Error())
+	}
+
+	uid, _ := strconv.ParseUint(u.Uid, 10, 32)
+	gid, _ := strconv.ParseUint(u.Gid, 10, 32)
+	credential := &LaunchCredential{Username: u.Username, HomeDir: u.HomeDir, Uid: uint32(uid), Gid: uint32(gid)}
+
+	if len(spec.Group) > 0 {
+		g, err := lookupGroup(spec.Group)
+		if err != nil {
+			return nil, fmt.Errorf("unknown group %s : %s", spec.Group, err.Error())
+		}
+		gid, _ = strconv.ParseUint(g.Gid, 10, 32)
+		credential.Gid = uint32(gid)
+	}
+
+	groupIds, err := u.GroupIds()
+	if err == nil {
+		for _, v := range groupIds {
+			id, err := strconv.ParseUint(v, 10, 32)
+			if err == nil {
+				credential.Groups = append(credential.Groups, uint32(id))
+			}
+		}
+	}
+
+	return credential, nil
+}
+
+func lookupUser(name string) (*user.User, error) {
+	if _, err := strconv.Atoi(name); err == nil {
+		return user.LookupId(name)
+	}
+	return user.Lookup(name)
+}
+
+func lookupGroup(name string) (*user.Group, error) {
+	if _, err := strconv.Atoi(name); err == nil {
+		return user.LookupGroupId(name)
+	}
+	return user.LookupGroup(name)
+}
+
+func (c *LaunchCredential) isCurrent() bool {
+	return c.Uid == uint32(os.Geteuid()) && c.Gid == uint32(os.Getegid())
+}
+
+// checkLaunchPrivilege 다른 사용자로 기동하려면 juno 가 root 권한으로 실행되고 있어야 한다
+func checkLaunchPrivilege(credential *LaunchCredential) error {
+	if credential == nil || credential.isCurrent() {
+		return nil
+	}
+
+	if os.Geteuid() != 0 {
+		return fmt.Errorf("juno(uid=%d) has no privilege to launch as %s(uid=%d, gid=%d)",
+			os.Geteuid(), credential.Username, credential.Uid, credential.Gid)
+	}
+	return nil
+}
+
+// verifyCredentialAccess app, log, data 디렉토리를 해당 사용자가 사용할 수 있는지 검사한다
+func verifyCredentialAccess(env fatima.FatimaEnv, proc string, credential *LaunchCredential) error {
+	if credential == nil || credential.Uid == 0 {
+		return nil
+	}
+
+	fatimaHome := env.GetFolderGuide().GetFatimaHome()
+	checks := []struct {
+		dir    string
+		access uint32
+	}{
+		{filepath.Join(fatimaHome, builder.FatimaFolderApp, proc), accessRead | accessExecute},
+		{filepath.Join(fatimaHome, builder.FatimaFolderApp, proc, builder.FatimaFolderProc), accessWrite | accessExecute},
+		{filepath.Join(fatimaHome, builder.FatimaFolderLog, proc), accessWrite | accessExecute},
+		{filepath.Join(fatimaHome, builder.FatimaFolderData, proc), accessWrite | accessExecute},
+	}
+
+	for _, c := range checks {
+		err := verifyDirAccess(c.dir, credential, c.access)
+		if err != nil {
+			return fmt.Errorf("%s(uid=%d) cannot access %s : %s", credential.Username, credential.Uid, c.dir, err.Error())
+		}
+	}
+	return nil
+}
+
+// verifyDirAccess 디렉토리가 없다면 생성 가능한지(상위 디렉토리 쓰기 권한) 검사하고 상위 경로 모두 탐색 권한이 있는지 검사한다
+func verifyDirAccess(dir string, credential *LaunchCredential, access uint32) error {
+	resolved, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return err
+		}
+		return verifyDirAccess(filepath.Dir(dir), credential, accessWrite|accessExecute)
+	}
+
+	err = checkModeAccess(resolved, credential, access)
+	if err != nil {
+		return err
+	}
+
+	for parent := filepath.Dir(resolved); ; parent = filepath.Dir(parent) {
+		err = checkModeAccess(parent, credential, accessExecute)
+		if err != nil {
+			return err
+		}
+		if parent == filepath.Dir(parent) {
+			break
+		}
+	}
+	return nil
+}
+
+func checkModeAccess(path string, credential *LaunchCredential, access uint32) error {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+
+	stat, ok := fi.Sys().(*syscall.Stat_t)
+	if !ok {
+		return nil
+	}
+
+	perm := uint32(fi.Mode().Perm())
+	var granted uint32
+	switch {
+	case stat.Uid == credential.Uid:
+		granted = (perm >> 6) & 7
+	case credential.hasGroup(stat.Gid):
+		granted = (perm >> 3) & 7
+	default:
+		granted = perm & 7
+	}
+
+	if granted&access != access {
+		return fmt.Errorf("permission denied on %s (mode=%s, owner=%d:%d)", path, fi.Mode().Perm(), stat.Uid, stat.Gid)
+	}
+	return nil
+}
+
+func (c *LaunchCredential) hasGroup(gid uint32) bool {
+	if c.Gid == gid {
+		return true
+	}
+	for _, g := range c.Groups {
+		if g == gid {
+			return true
+		}
+	}
+	return false
+}
+
+// applyLaunchCredential 검증 후 SysProcAttr.Credential 을 설정한다
+func applyLaunchCredential(env fatima.FatimaEnv, proc string, cmd *exec.Cmd, spec domain.LaunchSpec) error {
+	credential, err := resolveLaunchCredential(spec)
+	if err != nil || credential == nil {
+		return err
+	}
+
+	if credential.isCurrent() {
+		return nil
+	}
+
+	err = checkLaunchPrivilege(credential)
+	if err != nil {
+		return err
+	}
+
+	err = verifyCredentialAccess(env, proc, credential)
+	if err != nil {
+		return err
+	}
+
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	cmd.SysProcAttr.Credential = &syscall.Credential{
+		Uid:    credential.Uid,
+		Gid:    credential.Gid,
+		Groups: credential.Groups,
+	}
+	cmd.Env = overrideEnv(cmd.Env, "USER", credential.Username)
+	cmd.Env = overrideEnv(cmd.Env, "LOGNAME", credential.Username)
+	if len(credential.HomeDir) > 0 {
+		cmd.Env = overrideEnv(cmd.Env, "HOME", credential.HomeDir)
+	}
+	return nil
+}
+
+func overrideEnv(list []string, key, value string) []string {
+	prefix := key + "="
+	for i, kv := range list {
+		if strings.HasPrefix(kv, prefix) {
+			list[i] = prefix + value
+			return list
+		}
+	}
+	return append(list, prefix+value)
+}
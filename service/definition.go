@@ -0,0 +1,366 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 5:50
+ */
+
+package service
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fatima-go/fatima-core"
+	"github.com/fatima-go/fatima-core/builder"
+	"github.com/fatima-go/fatima-log"
+	"github.com/fatima-go/juno/domain"
+)
+
+const (
+	packageBackupDataDir = "package_backup"
+	// 유지할 fatima-package.yaml 백업 파일 개수
+	packageBackupKeepCount = 20
+	// OPM 그룹 id
+	opmGroupId = 1
+	// 정의 변경 후 재기동시 프로세스 종료를 기다리는 최대 시간
+	definitionStopDeadline = 30 * time.Second
+)
+
+// readPackageVersion fatima-package.yaml 내용의 hash 를 버전으로 사용한다
+func readPackageVersion(env fatima.FatimaEnv) (string, error) {
+	return readPackageVersionFile(env.GetFolderGuide().GetPackageProcFile())
+}
+
+func readPackageVersionFile(file string) (string, error) {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return "", fmt.Errorf("fail to read package yaml : %s", err.Error())
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:8]), nil
+}
+
+// checkPackageVersion 요청의 버전이 현재 파일의 버전과 다르면 다른 곳에서 먼저 변경한 것이다
+func checkPackageVersion(env fatima.FatimaEnv, version string) error {
+	return comparePackageVersion(env.GetFolderGuide().GetPackageProcFile(), version)
+}
+
+func comparePackageVersion(file string, version string) error {
+	current, err := readPackageVersionFile(file)
+	if err != nil {
+		return err
+	}
+	if current != version {
+		return fmt.Errorf("package definition was changed (current version %s). reload and try again", current)
+	}
+	return nil
+}
+
+// backupPackageYaml 변경 전의 fatima-package.yaml 을 보관한다
+func backupPackageYaml(env fatima.FatimaEnv) (string, error) {
+	return backupPackageFile(env.GetFolderGuide().GetPackageProcFile(),
+		filepath.Join(env.GetFolderGuide().GetDataFolder(), packageBackupDataDir), time.Now())
+}
+
+// backupPackageFile src 를 backupDir 에 복사하고 최근 packageBackupKeepCount 개만 남긴다
+func backupPackageFile(src string, backupDir string, now time.Time) (string, error) {
+	err := os.MkdirAll(backupDir, 0755)
+	if err != nil {
+		return "", fmt.Errorf("fail to make dir %s : %s", backupDir, err.Error())
+	}
+
+	dst := filepath.Join(backupDir,
+		fmt.Sprintf("%s.%s", filepath.Base(src), now.Format("20060102-150405.000")))
+	err = copyFile(src, dst)
+	if err != nil {
+		return "", fmt.Errorf("fail to backup package yaml : %s", err.Error())
+	}
+
+	files, err := filepath.Glob(filepath.Join(backupDir, filepath.Base(src)+".*"))
+	if err == nil && len(files) > packageBackupKeepCount {
+		sort.Strings(files)
+		for _, f := range files[:len(files)-packageBackupKeepCount] {
+			_ = os.Remove(f)
+		}
+	}
+	return dst, nil
+}
+
+func buildProcessDefinition(yamlConfig *builder.YamlFatimaPackageConfig, p builder.ProcessItem) domain.ProcessDefinition {
+	def := domain.ProcessDefinition{
+		Name:      p.Name,
+		Gid:       p.Gid,
+		LogLevel:  p.Loglevel,
+		Hb:        p.Hb,
+		Path:      p.Path,
+		Grep:      p.Grep,
+		Startmode: p.Startmode,
+		Weight:    p.Weight,
+		StartSec:  p.StartSec,
+	}
+	for _, g := range yamlConfig.Groups {
+		if g.Id == p.Gid {
+			def.Group = g.Name
+			break
+		}
+	}
+	return def
+}
+
+func (service *DomainService) GetPackageDefinition() (domain.PackageDefinition, error) {
+	env := service.fatimaRuntime.GetEnv()
+	packageMutex.Lock()
+	defer packageMutex.Unlock()
+
+	def := domain.PackageDefinition{}
+	version, err := readPackageVersion(env)
+	if err != nil {
+		return def, err
+	}
+	def.Version = version
+
+	yamlConfig := builder.NewYamlFatimaPackageConfig(env)
+	def.Groups = make([]domain.GroupDefinition, 0, len(yamlConfig.Groups))
+	for _, g := range yamlConfig.Groups {
+		def.Groups = append(def.Groups, domain.GroupDefinition{Id: g.Id, Name: g.Name})
+	}
+	def.Processes = make([]domain.ProcessDefinition, 0, len(yamlConfig.Processes))
+	for _, p := range yamlConfig.Processes {
+		def.Processes = append(def.Processes, buildProcessDefinition(yamlConfig, p))
+	}
+	return def, nil
+}
+
+func (service *DomainService) UpdateProcessDefinition(update domain.ProcessUpdate) (domain.DefinitionUpdateResult, error) {
+	log.Info("UpdateProcessDefinition. update=[%v]", update)
+
+	result := domain.DefinitionUpdateResult{}
+	err := update.Validate()
+	if err != nil {
+		return result, err
+	}
+
+	env := service.fatimaRuntime.GetEnv()
+	packageMutex.Lock()
+	defer packageMutex.Unlock()
+
+	err = checkPackageVersion(env, update.Version)
+	if err != nil {
+		return result, err
+	}
+
+	yamlConfig := builder.NewYamlFatimaPackageConfig(env)
+	found := -1
+	comp := strings.ToLower(update.Process)
+	for i, p := range yamlConfig.Processes {
+		if comp == strings.ToLower(p.GetName()) {
+			found = i
+			break
+		}
+	}
+	if found < 0 {
+		return result, fmt.Errorf("not found process %s", update.Process)
+	}
+
+	old := yamlConfig.Processes[found]
+	prev := buildProcessDefinition(yamlConfig, old)
+	item, err := applyProcessUpdate(yamlConfig, old, update)
+	if err != nil {
+		return result, err
+	}
+
+	running := findRunningPid(env, old, ScanProcessTable()) > 0
+	result.RestartRequired = running && update.NeedRestart(prev)
+	restart := result.RestartRequired && update.Restart
+	if restart && !markDefinitionRestart(item.Name) {
+		return result, fmt.Errorf("restart of %s is in progress", item.Name)
+	}
+
+	result.Backup, err = backupPackageYaml(env)
+	if err != nil {
+		if restart {
+			unmarkDefinitionRestart(item.Name)
+		}
+		return result, err
+	}
+
+	if restart {
+		// 저장 이후 재기동 전까지 모니터가 새로운 설정으로 상태를 판단하여 자동 재기동하지 않도록 한다
+		GetProcessMonitor().ProcessStop(item.Name)
+	}
+	yamlConfig.Processes[found] = item
+	yamlConfig.Save()
+	log.Warn("process definition changed. prev=[%v], new=[%v]", prev, item)
+
+	def := buildProcessDefinition(yamlConfig, item)
+	result.Process = &def
+	result.Version, _ = readPackageVersion(env)
+
+	if restart {
+		// packageMutex 를 잡은 채로 종료/기동을 기다리지 않는다. 결과는 process events 로 확인한다
+		result.RestartRequired = false
+		result.Restarting = true
+		go service.restartDefinedProcess(old, item)
+	}
+	return result, nil
+}
+
+// applyProcessUpdate 변경 요청을 반영한 프로세스 정의를 만든다
+func applyProcessUpdate(yamlConfig *builder.YamlFatimaPackageConfig, item builder.ProcessItem, update domain.ProcessUpdate) (builder.ProcessItem, error) {
+	if len(update.GroupId) > 0 {
+		gid, err := strconv.Atoi(update.GroupId)
+		if err != nil {
+			gid = yamlConfig.GetGroupId(update.GroupId)
+		}
+		if !yamlConfig.IsValidGroupId(gid) {
+			return item, fmt.Errorf("invalid group %s", update.GroupId)
+		}
+		if (gid == opmGroupId) != (item.Gid == opmGroupId) {
+			return item, fmt.Errorf("OPM group not permitted")
+		}
+		item.Gid = gid
+	}
+	if update.Hb != nil {
+		item.Hb = *update.Hb
+	}
+	if update.Path != nil {
+		item.Path = *update.Path
+	}
+	if update.Grep != nil {
+		item.Grep = *update.Grep
+	}
+	if update.Startmode != nil {
+		item.Startmode = *update.Startmode
+	}
+	if update.Weight != nil {
+		item.Weight = *update.Weight
+	}
+	if update.StartSec != nil {
+		item.StartSec = *update.StartSec
+	}
+	if err := checkDuplicatedGrep(item.Name, item.Grep, yamlConfig.Processes); err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+var (
+	definitionRestartMutex sync.Mutex
+	// definitionRestarts 정의 변경으로 재기동중인 프로세스
+	definitionRestarts = make(map[string]bool)
+)
+
+func markDefinitionRestart(proc string) bool {
+	definitionRestartMutex.Lock()
+	defer definitionRestartMutex.Unlock()
+	if definitionRestarts[proc] {
+		return false
+	}
+	definitionRestarts[proc] = true
+	return true
+}
+
+func unmarkDefinitionRestart(proc string) {
+	definitionRestartMutex.Lock()
+	defer definitionRestartMutex.Unlock()
+	delete(definitionRestarts, proc)
+}
+
+// restartDefinedProcess 변경 전 설정(path, grep)으로 종료를 확인한 뒤 변경된 설정으로 기동한다
+func (service *DomainService) restartDefinedProcess(old, item builder.ProcessItem) {
+	defer unmarkDefinitionRestart(item.Name)
+	env := service.fatimaRuntime.GetEnv()
+
+	results := domain.ProcessResults{stopProcess(env, old, ScanProcessTable())}
+	stopped := results[0]
+	if stopped.Outcome == domain.OutcomeFail || stopped.Outcome == domain.OutcomeNotPermitted {
+		log.Warn("fail to stop %s for definition change : %s", item.Name, stopped.Text())
+		GetProcessMonitor().ClearInternalJob(item.Name)
+		recordProcessResults(env, results, service.eventActor(), "definition changed")
+		return
+	}
+	waitTargetsStopped(env, []fatima.FatimaPkgProc{old}, definitionStopDeadline)
+
+	started := startProcess(env, item, ScanProcessTable())
+	if started.Outcome != domain.OutcomeSuccess {
+		// 기동되지 않았다면 ProcessStop 표시가 남아 모니터가 알람과 재기동을 계속 생략하게 된다
+		GetProcessMonitor().ClearInternalJob(item.Name)
+	}
+	results = append(results, started)
+	log.Warn("%s restarted for definition change : %s", item.Name, results.Text())
+	recordProcessResults(env, results, service.eventActor(), "definition changed")
+}
+
+func (service *DomainService) UpdateGroupDefinition(update domain.GroupUpdate) (domain.DefinitionUpdateResult, error) {
+	log.Info("UpdateGroupDefinition. update=[%v]", update)
+
+	result := domain.DefinitionUpdateResult{}
+	err := update.Validate()
+	if err != nil {
+		return result, err
+	}
+
+	env := service.fatimaRuntime.GetEnv()
+	packageMutex.Lock()
+	defer packageMutex.Unlock()
+
+	err = checkPackageVersion(env, update.Version)
+	if err != nil {
+		return result, err
+	}
+
+	yamlConfig := builder.NewYamlFatimaPackageConfig(env)
+	if update.Id == opmGroupId || strings.ToLower(update.Name) == "opm" {
+		return result, fmt.Errorf("OPM group not permitted")
+	}
+	if gid := yamlConfig.GetGroupId(update.Name); gid >= 0 && gid != update.Id {
+		return result, fmt.Errorf("aleady exist group %s", update.Name)
+	}
+
+	result.Backup, err = backupPackageYaml(env)
+	if err != nil {
+		return result, err
+	}
+
+	found := false
+	for i, g := range yamlConfig.Groups {
+		if g.Id == update.Id {
+			log.Warn("group renamed. id=%d, [%s] -> [%s]", g.Id, g.Name, update.Name)
+			yamlConfig.Groups[i].Name = update.Name
+			found = true
+			break
+		}
+	}
+	if !found {
+		log.Warn("group added. id=%d, name=%s", update.Id, update.Name)
+		yamlConfig.Groups = append(yamlConfig.Groups, builder.GroupItem{Id: update.Id, Name: update.Name})
+		sort.Sort(builder.GroupItems(yamlConfig.Groups))
+	}
+	yamlConfig.Save()
+
+	result.Group = &domain.GroupDefinition{Id: update.Id, Name: update.Name}
+	result.Version, _ = readPackageVersion(env)
+	return result, nil
+}
@@ -0,0 +1,105 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 6:44
+ */
+
+package service
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/fatima-go/fatima-core/builder"
+	"github.com/fatima-go/juno/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComparePackageVersion(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "fatima-package.yaml")
+	assert.Nil(t, os.WriteFile(file, []byte("process: []\n"), 0644))
+
+	version, err := readPackageVersionFile(file)
+	assert.Nil(t, err)
+	assert.Nil(t, comparePackageVersion(file, version))
+
+	// 다른 곳에서 먼저 변경했다면 이전 버전의 요청은 거절된다
+	assert.Nil(t, os.WriteFile(file, []byte("process: [{name: a}]\n"), 0644))
+	assert.NotNil(t, comparePackageVersion(file, version))
+}
+
+func TestBackupPackageFileRotation(t *testing.T) {
+	dir := t.TempDir()
+	src := filepath.Join(dir, "fatima-package.yaml")
+	assert.Nil(t, os.WriteFile(src, []byte("process: []\n"), 0644))
+	backupDir := filepath.Join(dir, packageBackupDataDir)
+
+	base := time.Date(2026, 10, 20, 11, 0, 0, 0, time.Local)
+	var last string
+	for i := 0; i < packageBackupKeepCount+3; i++ {
+		file, err := backupPackageFile(src, backupDir, base.Add(time.Duration(i)*time.Second))
+		assert.Nil(t, err)
+		last = file
+	}
+
+	files, err := filepath.Glob(filepath.Join(backupDir, "fatima-package.yaml.*"))
+	assert.Nil(t, err)
+	assert.Equal(t, packageBackupKeepCount, len(files))
+	assert.Contains(t, files, last)
+	assert.NotContains(t, files, filepath.Join(backupDir, "fatima-package.yaml."+base.Format("20060102-150405.000")))
+}
+
+func TestApplyProcessUpdateOpmGuard(t *testing.T) {
+	yamlConfig := &builder.YamlFatimaPackageConfig{
+		Groups: []builder.GroupItem{{Id: opmGroupId, Name: "OPM"}, {Id: 2, Name: "svc"}, {Id: 3, Name: "batch"}},
+	}
+	worker := builder.ProcessItem{Gid: 2, Name: "worker1"}
+	opm := builder.ProcessItem{Gid: opmGroupId, Name: "jupiter"}
+
+	_, err := applyProcessUpdate(yamlConfig, worker, domain.ProcessUpdate{GroupId: "OPM"})
+	assert.NotNil(t, err)
+	_, err = applyProcessUpdate(yamlConfig, opm, domain.ProcessUpdate{GroupId: "svc"})
+	assert.NotNil(t, err)
+	_, err = applyProcessUpdate(yamlConfig, worker, domain.ProcessUpdate{GroupId: "9"})
+	assert.NotNil(t, err)
+
+	weight := 4
+	item, err := applyProcessUpdate(yamlConfig, worker, domain.ProcessUpdate{GroupId: "batch", Weight: &weight})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, item.Gid)
+	assert.Equal(t, 4, item.Weight)
+}
+
+func TestApplyProcessUpdateDuplicatedGrep(t *testing.T) {
+	worker := builder.ProcessItem{Gid: 2, Name: "worker1", Grep: "worker1.jar"}
+	other := builder.ProcessItem{Gid: 2, Name: "worker2", Grep: "worker2.jar"}
+	yamlConfig := &builder.YamlFatimaPackageConfig{
+		Groups:    []builder.GroupItem{{Id: 2, Name: "svc"}},
+		Processes: []builder.ProcessItem{worker, other},
+	}
+
+	grep := "worker2.jar"
+	_, err := applyProcessUpdate(yamlConfig, worker, domain.ProcessUpdate{Grep: &grep})
+	assert.NotNil(t, err)
+
+	// 자기 자신의 grep 은 중복으로 보지 않는다
+	grep = "worker1.jar"
+	_, err = applyProcessUpdate(yamlConfig, worker, domain.ProcessUpdate{Grep: &grep})
+	assert.Nil(t, err)
+}
@@ -100,7 +100,7 @@ func (service *DomainService) DeployPackage(mr *multipart.Reader) (string, error
 		return "", err
 	}
 
-	err = deployToPackage(service.fatimaRuntime.GetEnv(), dep)
+	err = deployToPackage(service.fatimaRuntime.GetEnv(), dep, service.eventActor())
 	if err != nil {
 		return "", err
 	}
@@ -426,7 +426,7 @@ func extractFarfile(far *DeployRequest) (*Deployment, error) {
 	return &dep, nil
 }
 
-func deployToPackage(env fatima.FatimaEnv, dep *Deployment) error {
+func deployToPackage(env fatima.FatimaEnv, dep *Deployment, actor string) error {
 	yamlConfig := builder.NewYamlFatimaPackageConfig(env)
 	proc := yamlConfig.GetProcByName(dep.Process)
 
@@ -459,12 +459,13 @@ func deployToPackage(env fatima.FatimaEnv, dep *Deployment) error {
 	// stop process
 	appName := dep.Process
 	if dep.IsGeneralProcessType() {
-		pid := GetPid(env, proc)
+		pid := GetPidWithTable(env, proc, ScanProcessTable()).Pid
 		if pid > 1 {
 			if inspector.CheckProcessRunningByPid(proc.GetName(), pid) {
 				log.Warn("executing goaway %s [%d]", proc.GetName(), pid)
 				executeGoaway(env, proc, pid)
 				KillProgram(proc.GetName(), pid)
+				RecordProcessEvent(env, domain.ProcessEvent{Process: proc.GetName(), Event: domain.ProcessEventStopped, Pid: pid, Actor: actor, Reason: "deploy"})
 				// wait for previous process finish gracefully shutdown
 				time.Sleep(5 * time.Second)
 			}
@@ -478,10 +479,12 @@ func deployToPackage(env fatima.FatimaEnv, dep *Deployment) error {
 		log.Error("fail to link revision : %s", e.Error())
 		return e
 	}
+	RecordProcessEvent(env, domain.ProcessEvent{Process: appName, Event: domain.ProcessEventDeployActivated, Actor: actor,
+		Reason: fmt.Sprintf("revision %s", filepath.Base(dep.revisionPath))})
 
 	// start process
 	if dep.IsGeneralProcessType() {
-		startProcess(env, proc)
+		recordProcessResults(env, domain.ProcessResults{startProcess(env, proc, ScanProcessTable())}, actor, "deploy")
 	} else {
 		// remove all previous revision files
 		removeAllPreviousRevisions()
@@ -0,0 +1,277 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 5:54
+ */
+
+package service
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/fatima-go/fatima-core/builder"
+	"github.com/fatima-go/fatima-log"
+	"github.com/fatima-go/juno/domain"
+)
+
+const (
+	// SIGQUIT 이후 output 파일에 dump 가 기록되기를 기다리는 최대 시간
+	dumpOutputWait = 5 * time.Second
+	// dump 로 수집하는 output 의 최대 크기
+	dumpOutputLimit = 4 * 1024 * 1024
+	// 프로세스별 유지할 dump 파일 개수
+	dumpKeepCount  = 10
+	dumpFileSuffix = ".dump"
+)
+
+// buildDumpFile <proc>.<yyyyMMdd-HHmmss>.<pid>.<type>.dump
+func buildDumpFile(procDir, proc string, pid int, dumpType string, t time.Time) string {
+	return filepath.Join(procDir,
+		fmt.Sprintf("%s.%s.%d.%s%s", proc, t.Format("20060102-150405"), pid, dumpType, dumpFileSuffix))
+}
+
+func parseDumpFile(proc string, path string) (domain.DumpInfo, bool) {
+	info := domain.DumpInfo{Process: proc, File: filepath.Base(path)}
+	name := strings.TrimSuffix(strings.TrimPrefix(info.File, proc+"."), dumpFileSuffix)
+	parts := strings.Split(name, ".")
+	if len(parts) != 3 {
+		return info, false
+	}
+
+	t, err := time.ParseInLocation("20060102-150405", parts[0], time.Local)
+	if err != nil {
+		return info, false
+	}
+	info.Created = t.UnixMilli()
+	info.Pid, _ = strconv.Atoi(parts[1])
+	info.Type = parts[2]
+	info.Method = domain.DumpMethodSigquit
+	if fi, err := os.Stat(path); err == nil {
+		info.Size = fi.Size()
+	}
+	return info, true
+}
+
+func listDumpFiles(procDir, proc string) []string {
+	files, err := filepath.Glob(filepath.Join(procDir, proc+".*"+dumpFileSuffix))
+	if err != nil {
+		return nil
+	}
+	sort.Strings(files)
+	return files
+}
+
+// DumpProcess 실행중인 프로세스의 stack dump 를 SIGQUIT 으로 수집해서 proc 폴더에 저장한다
+// fatima(go) 와 native 프로세스는 SIGQUIT 으로 dump 후 종료되므로(go runtime) force 인 경우에만 수행하며
+// 종료된 프로세스는 재기동 정책에 따라 다시 기동된다
+func (service *DomainService) DumpProcess(proc string, force bool) (domain.ProcessDump, error) {
+	log.Info("DumpProcess. proc=[%s], force=[%t]", proc, force)
+
+	dump := domain.ProcessDump{}
+	env := service.fatimaRuntime.GetEnv()
+	yamlConfig := builder.NewYamlFatimaPackageConfig(env)
+	p := yamlConfig.GetProcByName(proc)
+	if p == nil {
+		return dump, fmt.Errorf("not found process %s", proc)
+	}
+
+	pid := findRunningPid(env, p, ScanProcessTable())
+	if pid < 1 {
+		return dump, fmt.Errorf("%s is not running", p.GetName())
+	}
+
+	procDir := getProcDir(env, p.GetName())
+	dump.Process = p.GetName()
+	dump.Pid = pid
+
+	var content string
+	var err error
+	switch {
+	case inspector.IsJavaProcess(p.GetName(), pid):
+		dump.Type, dump.Method = domain.DumpTypeJava, domain.DumpMethodSigquit
+		content, err = dumpBySigquit(procDir, p.GetName(), pid)
+	default:
+		dump.Type, dump.Method = domain.DumpTypeNative, domain.DumpMethodSigquit
+		if isFatimaOrientProcess(env, p) {
+			dump.Type = domain.DumpTypeFatima
+		}
+		if !force {
+			return dump, fmt.Errorf("SIGQUIT may terminate %s process %s. retry with force", dump.Type, p.GetName())
+		}
+		content, err = dumpBySigquit(procDir, p.GetName(), pid)
+	}
+	if err != nil {
+		return dump, err
+	}
+
+	now := time.Now()
+	file := buildDumpFile(procDir, p.GetName(), pid, dump.Type, now)
+	err = os.MkdirAll(procDir, 0755)
+	if err == nil {
+		err = os.WriteFile(file, []byte(content), 0644)
+	}
+	if err != nil {
+		return dump, fmt.Errorf("fail to save dump : %s", err.Error())
+	}
+	log.Warn("%s[%d] dump saved to %s", p.GetName(), pid, file)
+
+	files := listDumpFiles(procDir, p.GetName())
+	if len(files) > dumpKeepCount {
+		for _, f := range files[:len(files)-dumpKeepCount] {
+			_ = os.Remove(f)
+		}
+	}
+
+	dump.File = filepath.Base(file)
+	dump.Size = int64(len(content))
+	dump.Created = now.UnixMilli()
+	dump.Content = content
+	return dump, nil
+}
+
+// findOutputFile pid 의 output 파일. 없으면 가장 최근 파일
+func findOutputFile(procDir, proc string, pid int) string {
+	file := buildOutputFile(procDir, proc, pid)
+	if _, err := os.Stat(file); err == nil {
+		return file
+	}
+
+	files := listOutputFiles(procDir, proc, false)
+	if len(files) == 0 {
+		return ""
+	}
+	latest := ""
+	var latestTime time.Time
+	for _, f := range files {
+		fi, err := os.Stat(f)
+		if err == nil && fi.ModTime().After(latestTime) {
+			latest, latestTime = f, fi.ModTime()
+		}
+	}
+	return latest
+}
+
+func fileSize(path string) int64 {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return 0
+	}
+	return fi.Size()
+}
+
+// dumpBySigquit SIGQUIT 을 보낸 뒤 output 파일에 새로 기록된 내용을 dump 로 수집한다
+func dumpBySigquit(procDir, proc string, pid int) (string, error) {
+	output := findOutputFile(procDir, proc, pid)
+	if len(output) == 0 {
+		return "", fmt.Errorf("output of %s is not captured. enable %s", proc, propOutputCaptureEnable)
+	}
+
+	offset := fileSize(output)
+	err := syscall.Kill(pid, syscall.SIGQUIT)
+	if err != nil {
+		return "", fmt.Errorf("fail to send SIGQUIT to %d : %s", pid, err.Error())
+	}
+	log.Warn("send SIGQUIT to %s(%d)", proc, pid)
+
+	// 기록이 멈출 때까지 기다린다
+	size := offset
+	until := time.Now().Add(dumpOutputWait)
+	for time.Now().Before(until) {
+		time.Sleep(300 * time.Millisecond)
+		current := fileSize(output)
+		if current != offset && current == size {
+			break
+		}
+		size = current
+	}
+
+	if size < offset {
+		// rotate 된 경우
+		offset = 0
+	}
+	if size == offset {
+		return "", fmt.Errorf("no dump output from %s[%d] in %s", proc, pid, output)
+	}
+
+	f, err := os.Open(output)
+	if err != nil {
+		return "", fmt.Errorf("fail to read output : %s", err.Error())
+	}
+	defer f.Close()
+
+	length := size - offset
+	if length > dumpOutputLimit {
+		offset = size - dumpOutputLimit
+		length = dumpOutputLimit
+	}
+	b := make([]byte, length)
+	n, err := f.ReadAt(b, offset)
+	if err != nil && err != io.EOF {
+		return "", fmt.Errorf("fail to read output : %s", err.Error())
+	}
+	return string(b[:n]), nil
+}
+
+func (service *DomainService) ListDumps(proc string) ([]domain.DumpInfo, error) {
+	env := service.fatimaRuntime.GetEnv()
+	yamlConfig := builder.NewYamlFatimaPackageConfig(env)
+	p := yamlConfig.GetProcByName(proc)
+	if p == nil {
+		return nil, fmt.Errorf("not found process %s", proc)
+	}
+
+	list := make([]domain.DumpInfo, 0)
+	files := listDumpFiles(getProcDir(env, p.GetName()), p.GetName())
+	for i := len(files) - 1; i >= 0; i-- {
+		if info, ok := parseDumpFile(p.GetName(), files[i]); ok {
+			list = append(list, info)
+		}
+	}
+	return list, nil
+}
+
+func (service *DomainService) GetDump(proc string, file string) (domain.ProcessDump, error) {
+	dump := domain.ProcessDump{}
+	env := service.fatimaRuntime.GetEnv()
+	yamlConfig := builder.NewYamlFatimaPackageConfig(env)
+	p := yamlConfig.GetProcByName(proc)
+	if p == nil {
+		return dump, fmt.Errorf("not found process %s", proc)
+	}
+
+	path := filepath.Join(getProcDir(env, p.GetName()), filepath.Base(file))
+	info, ok := parseDumpFile(p.GetName(), path)
+	if !ok || !strings.HasPrefix(filepath.Base(file), p.GetName()+".") {
+		return dump, fmt.Errorf("invalid dump file %s", file)
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return dump, fmt.Errorf("fail to read dump : %s", err.Error())
+	}
+	dump.DumpInfo = info
+	dump.Content = string(b)
+	return dump, nil
+}
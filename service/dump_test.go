@@ -0,0 +1,49 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 5:54
+ */
+
+package service
+
+import (
+	"os"
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDumpBySigquit(t *testing.T) {
+	procDir := t.TempDir()
+	cmd := exec.Command("/bin/sh", "-c", `trap 'echo "full thread dump"' QUIT; echo started; while true; do sleep 0.1; done`)
+	output, err := os.Create(buildOutputFile(procDir, "sample", 0))
+	assert.Nil(t, err)
+	cmd.Stdout = output
+	assert.Nil(t, cmd.Start())
+	defer func() {
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+		_ = output.Close()
+	}()
+	time.Sleep(300 * time.Millisecond)
+
+	content, err := dumpBySigquit(procDir, "sample", cmd.Process.Pid)
+	assert.Nil(t, err)
+	assert.Equal(t, "full thread dump\n", content)
+}
@@ -0,0 +1,195 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 6:03
+ */
+
+package service
+
+import (
+	"runtime"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/fatima-go/juno/domain"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// 기억하는 자식 프로세스 종료 정보의 최대 개수
+	maxChildExitRecords = 1024
+	// 프로세스별로 보관하는 종료 이력 개수
+	exitHistoryKeepCount = 20
+	// pidfd 로 먼저 감지된 경우 reaper 가 종료 정보를 기록할 때까지 기다리는 시간
+	childExitAwait = 200 * time.Millisecond
+)
+
+var (
+	childExitMutex sync.Mutex
+	// childExits 회수된 자식 프로세스의 종료 정보 (pid -> exit)
+	childExits = make(map[int]domain.ProcessExit)
+	// childOwners juno 가 기동하여 reaper 가 회수할 자식 pid -> 프로세스 이름
+	childOwners = make(map[int]string)
+	// exitHistory 프로세스별 종료 이력 (오래된 순)
+	exitHistory = make(map[string][]domain.ProcessExit)
+)
+
+// registerChild ExecuteProgram 으로 기동한 자식 pid 를 기억한다. 회수될 때까지 유지된다
+func registerChild(pid int, proc string) {
+	childExitMutex.Lock()
+	defer childExitMutex.Unlock()
+	childOwners[pid] = proc
+}
+
+// ReapChildren 등록된 자식 프로세스 중 종료된 것을 회수한다
+// 스크립트, alarm command 등 exec.Cmd 로 직접 Wait 하는 자식은 회수하지 않는다
+func ReapChildren() {
+	childExitMutex.Lock()
+	pids := make([]int, 0, len(childOwners))
+	for pid := range childOwners {
+		pids = append(pids, pid)
+	}
+	childExitMutex.Unlock()
+
+	for _, pid := range pids {
+		var (
+			status syscall.WaitStatus
+			usage  syscall.Rusage
+		)
+		wpid, err := syscall.Wait4(pid, &status, syscall.WNOHANG, &usage)
+		if wpid == pid {
+			RecordChildExit(pid, status, &usage)
+		} else if err == syscall.ECHILD {
+			// 더이상 juno 의 자식이 아니다
+			childExitMutex.Lock()
+			delete(childOwners, pid)
+			childExitMutex.Unlock()
+		}
+	}
+}
+
+// RecordChildExit SIGCHLD reaper 가 회수한 자식 프로세스의 종료 정보를 관리 프로세스와 연결하여 기록한다
+func RecordChildExit(pid int, status syscall.WaitStatus, usage *syscall.Rusage) {
+	exit := buildProcessExit(pid, status, usage)
+
+	childExitMutex.Lock()
+	proc, ok := childOwners[pid]
+	delete(childOwners, pid)
+	childExitMutex.Unlock()
+	if !ok && procMonitor != nil {
+		proc = procMonitor.findProcessByPid(pid)
+	}
+	exit.Process = proc
+
+	childExitMutex.Lock()
+	if len(childExits) >= maxChildExitRecords {
+		childExits = make(map[int]domain.ProcessExit)
+	}
+	childExits[pid] = exit
+	if len(proc) > 0 {
+		history := append(exitHistory[proc], exit)
+		if len(history) > exitHistoryKeepCount {
+			history = history[len(history)-exitHistoryKeepCount:]
+		}
+		exitHistory[proc] = history
+	}
+	childExitMutex.Unlock()
+
+	notifyProcessExit(pid)
+}
+
+func buildProcessExit(pid int, status syscall.WaitStatus, usage *syscall.Rusage) domain.ProcessExit {
+	exit := domain.ProcessExit{Pid: pid, Time: time.Now().UnixMilli(), ExitCode: -1}
+	if status.Exited() {
+		exit.ExitCode = status.ExitStatus()
+	} else if status.Signaled() {
+		exit.Signal = unix.SignalName(status.Signal())
+		if len(exit.Signal) == 0 {
+			exit.Signal = status.Signal().String()
+		}
+		exit.CoreDumped = status.CoreDump()
+	}
+
+	if usage != nil {
+		exit.UserCpuMs = usage.Utime.Nano() / int64(time.Millisecond)
+		exit.SysCpuMs = usage.Stime.Nano() / int64(time.Millisecond)
+		exit.MaxRssKb = int64(usage.Maxrss)
+		if runtime.GOOS == "darwin" {
+			// darwin 의 maxrss 단위는 byte
+			exit.MaxRssKb = exit.MaxRssKb / 1024
+		}
+	}
+	return exit
+}
+
+// lookupChildExit 종료 정보를 알 수 있다면 리턴한다
+func lookupChildExit(pid int) (domain.ProcessExit, bool) {
+	childExitMutex.Lock()
+	defer childExitMutex.Unlock()
+	exit, ok := childExits[pid]
+	return exit, ok
+}
+
+// resolveProcessExit pid 의 종료 정보. juno 의 자식이 아니어서 회수하지 못했다면 Unknown 으로 표시한다
+func resolveProcessExit(pid int) domain.ProcessExit {
+	if exit, ok := lookupChildExit(pid); ok {
+		return exit
+	}
+	return domain.ProcessExit{Pid: pid, Time: time.Now().UnixMilli(), ExitCode: -1, Unknown: true}
+}
+
+// awaitChildExit juno 의 자식 프로세스라면 reaper 가 종료 정보를 기록할 때까지 잠시 기다린다
+func awaitChildExit(pid int, timeout time.Duration) (domain.ProcessExit, bool) {
+	deadline := time.Now().Add(timeout)
+	for {
+		if exit, ok := lookupChildExit(pid); ok {
+			return exit, true
+		}
+		childExitMutex.Lock()
+		_, child := childOwners[pid]
+		childExitMutex.Unlock()
+		if !child || time.Now().After(deadline) {
+			return domain.ProcessExit{}, false
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// lastProcessExit 프로세스의 가장 최근 종료 정보
+func lastProcessExit(proc string) *domain.ProcessExit {
+	childExitMutex.Lock()
+	defer childExitMutex.Unlock()
+	history := exitHistory[proc]
+	if len(history) == 0 {
+		return nil
+	}
+	exit := history[len(history)-1]
+	return &exit
+}
+
+// ListProcessExits 프로세스의 종료 이력을 최신순으로 리턴한다
+func (service *DomainService) ListProcessExits(proc string) []domain.ProcessExit {
+	childExitMutex.Lock()
+	defer childExitMutex.Unlock()
+	history := exitHistory[proc]
+	list := make([]domain.ProcessExit, 0, len(history))
+	for i := len(history) - 1; i >= 0; i-- {
+		list = append(list, history[i])
+	}
+	return list
+}
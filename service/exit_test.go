@@ -0,0 +1,88 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 6:03
+ */
+
+package service
+
+import (
+	"os/exec"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildProcessExit(t *testing.T) {
+	usage := &syscall.Rusage{Utime: syscall.NsecToTimeval(int64(1500 * time.Millisecond)), Maxrss: 2048}
+	exit := buildProcessExit(100, syscall.WaitStatus(3<<8), usage)
+	assert.Equal(t, 3, exit.ExitCode)
+	assert.Equal(t, "", exit.Signal)
+	assert.Equal(t, int64(1500), exit.UserCpuMs)
+	assert.False(t, exit.IsNormal())
+
+	// SIGSEGV + core dump
+	exit = buildProcessExit(100, syscall.WaitStatus(int(syscall.SIGSEGV)|0x80), nil)
+	assert.Equal(t, -1, exit.ExitCode)
+	assert.Equal(t, "SIGSEGV", exit.Signal)
+	assert.True(t, exit.CoreDumped)
+	assert.Contains(t, exit.Text(), "killed by SIGSEGV (core dumped)")
+
+	assert.True(t, buildProcessExit(100, syscall.WaitStatus(0), nil).IsNormal())
+}
+
+func TestRecordChildExitHistory(t *testing.T) {
+	registerChild(4242, "exittest")
+	RecordChildExit(4242, syscall.WaitStatus(1<<8), nil)
+
+	exit, ok := lookupChildExit(4242)
+	assert.True(t, ok)
+	assert.Equal(t, "exittest", exit.Process)
+	assert.Equal(t, 1, lastProcessExit("exittest").ExitCode)
+
+	list := (&DomainService{}).ListProcessExits("exittest")
+	assert.Equal(t, 1, len(list))
+}
+
+func TestReapChildrenOnlyRegistered(t *testing.T) {
+	managed := exec.Command("sh", "-c", "exit 3")
+	assert.Nil(t, managed.Start())
+	registerChild(managed.Process.Pid, "test.reap.managed")
+
+	// exec.Cmd 로 직접 Wait 하는 자식은 reaper 가 회수하지 않아야 한다
+	script := exec.Command("sh", "-c", "exit 0")
+	assert.Nil(t, script.Start())
+
+	time.Sleep(200 * time.Millisecond)
+	ReapChildren()
+
+	exit, ok := lookupChildExit(managed.Process.Pid)
+	assert.True(t, ok)
+	assert.Equal(t, 3, exit.ExitCode)
+	assert.Equal(t, "test.reap.managed", exit.Process)
+	assert.Nil(t, script.Wait())
+}
+
+func TestResolveProcessExitUnknown(t *testing.T) {
+	// juno 가 회수하지 않은 pid 는 unknown 으로 보고하고 정상 종료로 보지 않는다
+	exit := resolveProcessExit(999999)
+	assert.True(t, exit.Unknown)
+	assert.False(t, exit.IsNormal())
+	assert.Contains(t, exit.Text(), "unknown")
+}
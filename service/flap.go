@@ -0,0 +1,182 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 6:08
+ */
+
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/fatima-go/fatima-core"
+	"github.com/fatima-go/fatima-core/monitor"
+	"github.com/fatima-go/fatima-log"
+	"github.com/fatima-go/juno/domain"
+)
+
+const (
+	// window 동안 transitions 회 이상 상태가 변경되면 flapping 으로 판단한다
+	propFlapTransitions = "flap.transitions"
+	propFlapWindowSec   = "flap.window.sec"
+	// flapping 중 stable 기간 동안 상태 변경이 없으면 해소된 것으로 본다
+	propFlapStableSec = "flap.stable.sec"
+)
+
+type flapConfig struct {
+	transitions int
+	windowSec   int
+	stableSec   int
+}
+
+var defaultFlapConfig = flapConfig{transitions: 6, windowSec: 300, stableSec: 300}
+
+func loadFlapConfig(config fatima.Config) {
+	if v, err := config.GetInt(propFlapTransitions); err == nil && v > 1 {
+		defaultFlapConfig.transitions = v
+	}
+	if v, err := config.GetInt(propFlapWindowSec); err == nil && v > 0 {
+		defaultFlapConfig.windowSec = v
+	}
+	if v, err := config.GetInt(propFlapStableSec); err == nil && v > 0 {
+		defaultFlapConfig.stableSec = v
+	}
+}
+
+// flapRecord 프로세스별 상태 변경 이력
+type flapRecord struct {
+	transitions    []int64 // window 안의 상태 변경 시각 (unix millis)
+	lastTransition int64
+	flapping       bool
+	since          int64
+	total          int // flapping 기간 동안의 상태 변경 횟수
+	restarts       int // flapping 기간 동안의 재기동 횟수
+	suppressed     int // flapping 기간 동안 생략된 알람 수
+}
+
+// observe 상태 변경을 기록한다. 이번 변경으로 flapping 이 시작되었다면 true
+func (r *flapRecord) observe(now int64, config flapConfig) bool {
+	r.lastTransition = now
+	if r.flapping {
+		r.total++
+		return false
+	}
+
+	limit := now - int64(config.windowSec)*1000
+	kept := r.transitions[:0]
+	for _, t := range r.transitions {
+		if t > limit {
+			kept = append(kept, t)
+		}
+	}
+	r.transitions = append(kept, now)
+	if len(r.transitions) < config.transitions {
+		return false
+	}
+
+	r.flapping = true
+	r.since = r.transitions[0]
+	r.total = len(r.transitions)
+	r.restarts = 0
+	r.suppressed = 0
+	r.transitions = r.transitions[:0]
+	return true
+}
+
+// recovered flapping 중 stable 기간 동안 상태 변경이 없었다면 true
+func (r *flapRecord) recovered(now int64, config flapConfig) bool {
+	return r.flapping && now-r.lastTransition >= int64(config.stableSec)*1000
+}
+
+func (r *flapRecord) state() *domain.FlapState {
+	if !r.flapping {
+		return nil
+	}
+	return &domain.FlapState{Since: r.since, Transitions: r.total, Suppressed: r.suppressed}
+}
+
+func (p *processMonitor) flapRecordOf(proc string) *flapRecord {
+	record, ok := p.flaps[proc]
+	if !ok {
+		record = &flapRecord{}
+		p.flaps[proc] = record
+	}
+	return record
+}
+
+// observeFlap 상태 변경 알람을 보내야 하는지 판단한다. flapping 중이라면 개별 알람은 생략한다
+// monMutex 를 잡은 상태에서 호출되어야 한다
+func (p *processMonitor) observeFlap(next domain.ProcessInfo) bool {
+	record := p.flapRecordOf(next.Name)
+	wasFlapping := record.flapping
+	if record.observe(time.Now().UnixMilli(), defaultFlapConfig) {
+		log.Warn("[%s] flapping detected. %d transitions in %d sec", next.Name, record.total, defaultFlapConfig.windowSec)
+		msg := fmt.Sprintf("프로세스 flapping 감지 : [%s]의 상태가 %d초 동안 %d회 변경 되었습니다. 안정될 때까지 개별 알람을 생략합니다",
+			next.Name, defaultFlapConfig.windowSec, record.total)
+		raiseAlarm(monitor.AlamLevelMajor, AlarmCategoryMonitor, domain.AlarmEventFlapping, next.Name, msg)
+		return false
+	}
+	if wasFlapping {
+		record.suppressed++
+		return false
+	}
+	return true
+}
+
+// suppressRestartAlarm flapping 중이라면 재기동 알람을 생략하고 재기동 횟수만 센다
+// monMutex 를 잡은 상태에서 호출되어야 한다
+func (p *processMonitor) suppressRestartAlarm(proc string) bool {
+	record, ok := p.flaps[proc]
+	if !ok || !record.flapping {
+		return false
+	}
+	record.restarts++
+	record.suppressed++
+	return true
+}
+
+// flapEndAlarmLevel flapping 이 끝났을 때 프로세스가 중단된 상태라면 생략했던 DEAD 알람을 대신하여 MAJOR 로 알린다
+func flapEndAlarmLevel(item *domain.ProcessInfo) monitor.AlarmLevel {
+	if item.IsRunning() {
+		return monitor.AlarmLevelMinor
+	}
+	return monitor.AlamLevelMajor
+}
+
+// reflectFlapState flapping 이 해소되었다면 요약 알람을 보낸다
+// monMutex 를 잡은 상태에서 호출되어야 한다
+func (p *processMonitor) reflectFlapState(item *domain.ProcessInfo, now int64) {
+	record, ok := p.flaps[item.Name]
+	if !ok {
+		return
+	}
+	if record.recovered(now, defaultFlapConfig) {
+		duration := time.Duration(record.lastTransition-record.since) * time.Millisecond
+		msg := fmt.Sprintf("프로세스 flapping 해소 : [%s] 현재 상태 %s\n기간 %s, 상태 변경 %d회, 재기동 %d회, 생략된 알람 %d건",
+			item.Name, item.Status, duration.Round(time.Second), record.total, record.restarts, record.suppressed)
+		if item.IsRunning() {
+			log.Info("[%s] flapping recovered. status=%s", item.Name, item.Status)
+		} else {
+			log.Warn("[%s] flapping ended but process is not running. status=%s", item.Name, item.Status)
+			msg = fmt.Sprintf("%s\n프로세스가 중단된 상태로 flapping 이 끝났습니다", msg)
+		}
+		raiseAlarm(flapEndAlarmLevel(item), AlarmCategoryMonitor, domain.AlarmEventFlapRecovered, item.Name, msg)
+		record.flapping = false
+	}
+	item.Flapping = record.state()
+}
@@ -0,0 +1,57 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 6:08
+ */
+
+package service
+
+import (
+	"testing"
+
+	"github.com/fatima-go/fatima-core/monitor"
+	"github.com/fatima-go/juno/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlapRecord(t *testing.T) {
+	config := flapConfig{transitions: 4, windowSec: 60, stableSec: 120}
+	record := &flapRecord{}
+
+	// window 를 벗어난 변경은 세지 않는다
+	assert.False(t, record.observe(0, config))
+	assert.False(t, record.observe(70*1000, config))
+	assert.False(t, record.observe(80*1000, config))
+	assert.False(t, record.observe(90*1000, config))
+	assert.True(t, record.observe(100*1000, config))
+	assert.Equal(t, int64(70*1000), record.since)
+	assert.Equal(t, 4, record.total)
+
+	// flapping 중에는 다시 시작되지 않고 횟수만 누적된다
+	assert.False(t, record.observe(110*1000, config))
+	assert.Equal(t, 5, record.total)
+	assert.NotNil(t, record.state())
+
+	assert.False(t, record.recovered(200*1000, config))
+	assert.True(t, record.recovered(230*1000, config))
+}
+
+func TestFlapEndAlarmLevel(t *testing.T) {
+	assert.Equal(t, monitor.AlarmLevel(monitor.AlarmLevelMinor), flapEndAlarmLevel(&domain.ProcessInfo{Status: domain.PROC_STATUS_ALIVE}))
+	// 중단된 상태로 flapping 이 끝나면 생략된 DEAD 알람 대신 MAJOR 로 알린다
+	assert.Equal(t, monitor.AlarmLevel(monitor.AlamLevelMajor), flapEndAlarmLevel(&domain.ProcessInfo{Status: domain.PROC_STATUS_DEAD}))
+}
@@ -126,3 +126,8 @@ func handshakeGoawayMessage(client FatimaIPCClientSession, expectedCommand strin
 	}
 	return nil
 }
+
+// MaxGoawayDuration IPC goaway 가 완료될 때까지 기다리는 최대 시간
+func MaxGoawayDuration() time.Duration {
+	return goawayStartTimeoutDuration + goawayTimeoutDuration
+}
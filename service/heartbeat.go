@@ -0,0 +1,279 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 6:16
+ */
+
+package service
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/fatima-go/fatima-core"
+	"github.com/fatima-go/fatima-core/builder"
+	"github.com/fatima-go/fatima-log"
+	"github.com/fatima-go/juno/domain"
+)
+
+const (
+	// hb: true 인 프로세스의 heartbeat 확인 방법. monitor
+	propHeartbeatMethod = "hb.method"
+	// monitor 파일이 timeout 이상 갱신되지 않으면 누락으로 본다
+	propHeartbeatTimeoutSec = "hb.timeout.sec"
+	// heartbeat 확인 주기
+	propHeartbeatIntervalSec = "hb.interval.sec"
+	// 연속 누락 횟수가 miss.count 에 도달하면 HUNG 으로 판단한다
+	propHeartbeatMissCount = "hb.miss.count"
+	// HUNG 으로 판단되었을 때의 조치. alarm, dump, restart
+	propHeartbeatAction = "hb.action"
+	// 기동 후 grace 기간 동안은 누락을 세지 않는다
+	propHeartbeatGraceSec = "hb.grace.sec"
+)
+
+type heartbeatConfig struct {
+	method      string
+	timeoutSec  int
+	intervalSec int
+	missCount   int
+	action      string
+	graceSec    int
+}
+
+var defaultHeartbeatConfig = heartbeatConfig{
+	method:      domain.HeartbeatMethodMonitor,
+	timeoutSec:  30,
+	intervalSec: 10,
+	missCount:   3,
+	action:      domain.HeartbeatActionAlarm,
+	graceSec:    60,
+}
+
+func loadHeartbeatConfig(config fatima.Config) {
+	if v, ok := config.GetValue(propHeartbeatMethod); ok {
+		if domain.IsValidHeartbeatMethod(v) {
+			defaultHeartbeatConfig.method = v
+		} else {
+			log.Warn("invalid or unsupported %s : %s. use %s", propHeartbeatMethod, v, defaultHeartbeatConfig.method)
+		}
+	}
+	if v, err := config.GetInt(propHeartbeatTimeoutSec); err == nil && v > 0 {
+		defaultHeartbeatConfig.timeoutSec = v
+	}
+	if v, err := config.GetInt(propHeartbeatIntervalSec); err == nil && v > 0 {
+		defaultHeartbeatConfig.intervalSec = v
+	}
+	if v, err := config.GetInt(propHeartbeatMissCount); err == nil && v > 0 {
+		defaultHeartbeatConfig.missCount = v
+	}
+	if v, ok := config.GetValue(propHeartbeatAction); ok {
+		if domain.IsValidHeartbeatAction(v) {
+			defaultHeartbeatConfig.action = v
+		} else {
+			log.Warn("invalid %s : %s", propHeartbeatAction, v)
+		}
+	}
+	if v, err := config.GetInt(propHeartbeatGraceSec); err == nil && v >= 0 {
+		defaultHeartbeatConfig.graceSec = v
+	}
+}
+
+// heartbeatProbe 한번의 heartbeat 확인 결과
+type heartbeatProbe struct {
+	ok       bool
+	lastBeat int64 // 확인된 heartbeat 시각 (unix millis). 알 수 없으면 0
+}
+
+// heartbeatRecord 프로세스별 heartbeat 누락 이력
+type heartbeatRecord struct {
+	pid       string
+	firstSeen int64
+	lastCheck int64
+	lastBeat  int64
+	misses    int
+	hung      bool
+	hungSince int64
+}
+
+func (r *heartbeatRecord) due(now int64, config heartbeatConfig) bool {
+	return now-r.lastCheck >= int64(config.intervalSec)*1000
+}
+
+// observe 확인 결과를 반영한다. 이번 확인으로 HUNG 이 되었다면 entered, HUNG 에서 벗어났다면 recovered
+func (r *heartbeatRecord) observe(probe heartbeatProbe, now int64, config heartbeatConfig) (entered, recovered bool) {
+	r.lastCheck = now
+	if probe.lastBeat > 0 {
+		r.lastBeat = probe.lastBeat
+	}
+
+	if probe.ok {
+		r.misses = 0
+		if r.hung {
+			r.hung = false
+			r.hungSince = 0
+			return false, true
+		}
+		return false, false
+	}
+
+	if now-r.firstSeen < int64(config.graceSec)*1000 {
+		return false, false
+	}
+
+	r.misses++
+	if !r.hung && r.misses >= config.missCount {
+		r.hung = true
+		r.hungSince = now
+		return true, false
+	}
+	return false, false
+}
+
+func (r *heartbeatRecord) state(config heartbeatConfig) *domain.HeartbeatState {
+	state := &domain.HeartbeatState{
+		Method:    config.method,
+		LastBeat:  r.lastBeat,
+		Misses:    r.misses,
+		HungSince: r.hungSince,
+	}
+	if r.hung {
+		state.Action = config.action
+	}
+	return state
+}
+
+// heartbeatTargets 이번 scan 에서 heartbeat 를 확인해야 하는 hb 프로세스의 pid
+func (p *processMonitor) heartbeatTargets(processes []*domain.ProcessInfo, now int64) map[string]int {
+	p.monMutex.Lock()
+	defer p.monMutex.Unlock()
+	targets := make(map[string]int)
+	for _, item := range processes {
+		if item.Status != domain.PROC_STATUS_ALIVE || !p.isHeartbeatProcess(item.Name) {
+			continue
+		}
+		pid, err := strconv.Atoi(item.Pid)
+		if err != nil {
+			continue
+		}
+		if record, ok := p.heartbeats[item.Name]; ok && record.pid == item.Pid && !record.due(now, defaultHeartbeatConfig) {
+			continue
+		}
+		targets[item.Name] = pid
+	}
+	return targets
+}
+
+// probeHeartbeats hb 프로세스들의 heartbeat 를 동시에 확인한다
+func (p *processMonitor) probeHeartbeats(processes []*domain.ProcessInfo) map[string]heartbeatProbe {
+	now := time.Now()
+	targets := p.heartbeatTargets(processes, now.UnixMilli())
+	probes := make(map[string]heartbeatProbe)
+	if len(targets) == 0 {
+		return probes
+	}
+
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+	for proc, pid := range targets {
+		wg.Add(1)
+		go func(proc string, pid int) {
+			defer wg.Done()
+			probe := probeHeartbeatMonitorFile(buildHeartbeatMonitorFile(p.fatimaRuntime.GetEnv(), proc, pid), now, defaultHeartbeatConfig.timeoutSec)
+			mutex.Lock()
+			probes[proc] = probe
+			mutex.Unlock()
+		}(proc, pid)
+	}
+	wg.Wait()
+	return probes
+}
+
+func buildHeartbeatMonitorFile(env fatima.FatimaEnv, proc string, pid int) string {
+	return filepath.Join(env.GetFolderGuide().GetFatimaHome(),
+		builder.FatimaFolderApp,
+		proc,
+		builder.FatimaFolderProc,
+		"monitor",
+		fmt.Sprintf("%s.%d.monitor", proc, pid))
+}
+
+// probeHeartbeatMonitorFile fatima 프로세스가 주기적으로 기록하는 monitor 파일의 갱신 시각으로 heartbeat 를 확인한다
+func probeHeartbeatMonitorFile(file string, now time.Time, timeoutSec int) heartbeatProbe {
+	fi, err := os.Stat(file)
+	if err != nil {
+		log.Debug("fail to stat monitor file : %s", err.Error())
+		return heartbeatProbe{}
+	}
+	probe := heartbeatProbe{lastBeat: fi.ModTime().UnixMilli()}
+	probe.ok = now.Sub(fi.ModTime()) <= time.Duration(timeoutSec)*time.Second
+	return probe
+}
+
+// reflectHeartbeat heartbeat 확인 결과를 반영한다. 연속 누락으로 HUNG 이 되었다면 상태를 바꾸고 조치를 수행한다
+// monMutex 를 잡은 상태에서 호출되어야 한다
+func (p *processMonitor) reflectHeartbeat(item *domain.ProcessInfo, now int64, probes map[string]heartbeatProbe) {
+	if item.Status != domain.PROC_STATUS_ALIVE || p.isInternalJob(item.Name) || !p.isHeartbeatProcess(item.Name) {
+		delete(p.heartbeats, item.Name)
+		return
+	}
+
+	if _, ok := findMaintenance(p.fatimaRuntime.GetEnv(), item.Name, item.Group); ok {
+		delete(p.heartbeats, item.Name)
+		return
+	}
+
+	record, ok := p.heartbeats[item.Name]
+	if !ok || record.pid != item.Pid {
+		record = &heartbeatRecord{pid: item.Pid, firstSeen: now}
+		p.heartbeats[item.Name] = record
+	}
+
+	if probe, ok := probes[item.Name]; ok {
+		entered, recovered := record.observe(probe, now, defaultHeartbeatConfig)
+		if entered {
+			log.Warn("[%s] heartbeat missed %d times. hung. action=%s", item.Name, record.misses, defaultHeartbeatConfig.action)
+			pid, _ := strconv.Atoi(item.Pid)
+			RecordProcessEvent(p.fatimaRuntime.GetEnv(), domain.ProcessEvent{Process: item.Name, Event: domain.ProcessEventHung, Pid: pid,
+				Actor: domain.EventActorMonitor, Reason: fmt.Sprintf("heartbeat missed %d times. action=%s", record.misses, defaultHeartbeatConfig.action)})
+			switch defaultHeartbeatConfig.action {
+			case domain.HeartbeatActionRestart:
+				go p.restartByAction(item.Name, "heartbeat")
+			case domain.HeartbeatActionDump:
+				go p.dumpByAction(item.Name, "heartbeat")
+			}
+		} else if recovered {
+			log.Info("[%s] heartbeat recovered", item.Name)
+		}
+	}
+
+	if record.hung {
+		item.Status = domain.PROC_STATUS_HUNG
+	}
+	item.Heartbeat = record.state(defaultHeartbeatConfig)
+}
+
+func (p *processMonitor) isHeartbeatProcess(proc string) bool {
+	if p.yamlConfig == nil {
+		return false
+	}
+	pkgProc := p.yamlConfig.GetProcByName(proc)
+	return pkgProc != nil && pkgProc.GetHeartbeat()
+}
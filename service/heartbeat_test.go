@@ -0,0 +1,78 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 6:16
+ */
+
+package service
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHeartbeatRecord(t *testing.T) {
+	config := heartbeatConfig{method: "monitor", timeoutSec: 30, intervalSec: 10, missCount: 3, action: "alarm", graceSec: 60}
+	record := &heartbeatRecord{pid: "100", firstSeen: 0}
+
+	// grace 기간 동안은 누락을 세지 않는다
+	entered, _ := record.observe(heartbeatProbe{}, 30*1000, config)
+	assert.False(t, entered)
+	assert.Equal(t, 0, record.misses)
+
+	record.observe(heartbeatProbe{}, 60*1000, config)
+	record.observe(heartbeatProbe{}, 70*1000, config)
+	assert.False(t, record.hung)
+	entered, _ = record.observe(heartbeatProbe{}, 80*1000, config)
+	assert.True(t, entered)
+	assert.True(t, record.hung)
+	assert.Equal(t, "alarm", record.state(config).Action)
+
+	// HUNG 중의 추가 누락은 다시 진입하지 않는다
+	entered, _ = record.observe(heartbeatProbe{}, 90*1000, config)
+	assert.False(t, entered)
+	assert.Equal(t, 4, record.misses)
+
+	_, recovered := record.observe(heartbeatProbe{ok: true, lastBeat: 95 * 1000}, 100*1000, config)
+	assert.True(t, recovered)
+	assert.False(t, record.hung)
+	assert.Equal(t, 0, record.misses)
+	assert.Equal(t, int64(95*1000), record.lastBeat)
+
+	assert.False(t, record.due(105*1000, config))
+	assert.True(t, record.due(110*1000, config))
+}
+
+func TestProbeHeartbeatMonitorFile(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "sample.1234.monitor")
+
+	now := time.Now()
+	assert.False(t, probeHeartbeatMonitorFile(file, now, 30).ok)
+
+	assert.Nil(t, os.WriteFile(file, []byte("monitor"), 0644))
+	assert.True(t, probeHeartbeatMonitorFile(file, now, 30).ok)
+
+	old := now.Add(-time.Minute)
+	assert.Nil(t, os.Chtimes(file, old, old))
+	probe := probeHeartbeatMonitorFile(file, now, 30)
+	assert.False(t, probe.ok)
+	assert.Equal(t, old.UnixMilli(), probe.lastBeat)
+}
@@ -0,0 +1,299 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 6:19
+ */
+
+package service
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/fatima-go/fatima-core"
+	"github.com/fatima-go/fatima-core/builder"
+	"github.com/fatima-go/fatima-log"
+	"github.com/fatima-go/juno/domain"
+)
+
+const (
+	// 프로세스별 lifecycle 이벤트는 data 폴더의 events/<proc>.log 에 한 줄씩 기록한다
+	journalDataDir          = "events"
+	propJournalKeepCount    = "event.history.keep.count"
+	defaultJournalKeepCount = 500
+	propJournalKeepDay      = "event.history.keep.day"
+	defaultJournalKeepDay   = 90
+	// keep.count 를 slack 만큼 넘으면 파일을 정리한다
+	journalCompactSlack = 100
+	// process report 에 포함하는 최근 이벤트 수
+	reportTimelineLimit = 20
+)
+
+var (
+	journalMutex     sync.Mutex
+	journalLines     = make(map[string]int)
+	journalKeepCount = defaultJournalKeepCount
+	journalKeepDay   = defaultJournalKeepDay
+)
+
+func loadJournalConfig(config fatima.Config) {
+	if v, err := config.GetInt(propJournalKeepCount); err == nil && v > 0 {
+		journalKeepCount = v
+	}
+	if v, err := config.GetInt(propJournalKeepDay); err == nil && v > 0 {
+		journalKeepDay = v
+	}
+}
+
+func buildJournalFile(env fatima.FatimaEnv, proc string) string {
+	return filepath.Join(env.GetFolderGuide().GetDataFolder(), journalDataDir, proc+".log")
+}
+
+// RecordProcessEvent 프로세스 lifecycle 이벤트를 journal 에 기록한다
+func RecordProcessEvent(env fatima.FatimaEnv, event domain.ProcessEvent) {
+	if len(event.Process) == 0 {
+		return
+	}
+	if event.Time == 0 {
+		event.Time = time.Now().UnixMilli()
+	}
+	log.Info("[%s] process event : %s", event.Process, event.Text())
+
+	b, err := json.Marshal(event)
+	if err != nil {
+		return
+	}
+
+	file := buildJournalFile(env, event.Process)
+	journalMutex.Lock()
+	defer journalMutex.Unlock()
+
+	lines, ok := journalLines[event.Process]
+	if !ok {
+		lines = countJournalLines(file)
+	}
+
+	_ = os.MkdirAll(filepath.Dir(file), 0755)
+	f, err := os.OpenFile(file, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		log.Warn("fail to write process event : %s", err.Error())
+		return
+	}
+	_, err = f.Write(append(b, '\n'))
+	f.Close()
+	if err != nil {
+		log.Warn("fail to write process event : %s", err.Error())
+		return
+	}
+	lines++
+
+	if lines > journalKeepCount+journalCompactSlack {
+		kept, err := compactJournal(file, journalKeepCount, journalKeepDay, time.Now())
+		if err != nil {
+			log.Warn("fail to compact process event : %s", err.Error())
+		} else {
+			lines = kept
+		}
+	}
+	journalLines[event.Process] = lines
+}
+
+// recordProcessResults start/stop 명령의 결과 중 성공한 것을 이벤트로 기록한다
+func recordProcessResults(env fatima.FatimaEnv, results domain.ProcessResults, actor, reason string) {
+	for _, r := range results {
+		if r.Outcome != domain.OutcomeSuccess {
+			continue
+		}
+		switch r.Action {
+		case domain.ProcessActionStart:
+			RecordProcessEvent(env, domain.ProcessEvent{Process: r.Name, Event: domain.ProcessEventStarted, Pid: r.NewPid, Actor: actor, Reason: reason})
+		case domain.ProcessActionStop:
+			RecordProcessEvent(env, domain.ProcessEvent{Process: r.Name, Event: domain.ProcessEventStopped, Pid: r.OldPid, Actor: actor, Reason: reason})
+		}
+	}
+}
+
+func countJournalLines(file string) int {
+	f, err := os.Open(file)
+	if err != nil {
+		return 0
+	}
+	defer f.Close()
+
+	count := 0
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		count++
+	}
+	return count
+}
+
+// trimLineFile 한 줄 단위로 기록되는 파일에서 최근 keepCount 줄만 남긴다
+func trimLineFile(file string, keepCount int) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	lines := make([][]byte, 0)
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		lines = append(lines, append([]byte(nil), scanner.Bytes()...))
+		if len(lines) > keepCount {
+			lines = lines[1:]
+		}
+	}
+	f.Close()
+	if err = scanner.Err(); err != nil {
+		return fmt.Errorf("fail to read %s : %s", file, err.Error())
+	}
+
+	tmp := file + ".tmp"
+	out, err := os.OpenFile(tmp, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return fmt.Errorf("fail to create %s : %s", tmp, err.Error())
+	}
+	w := bufio.NewWriter(out)
+	for _, line := range lines {
+		_, _ = w.Write(append(line, '\n'))
+	}
+	err = w.Flush()
+	out.Close()
+	if err != nil {
+		os.Remove(tmp)
+		return fmt.Errorf("fail to write %s : %s", tmp, err.Error())
+	}
+	if err = os.Rename(tmp, file); err != nil {
+		os.Remove(tmp)
+		return fmt.Errorf("fail to rename %s : %s", tmp, err.Error())
+	}
+	return nil
+}
+
+// readJournal journal 파일의 이벤트를 기록 순서대로 읽는다. keepDay 가 지난 이벤트는 제외한다
+func readJournal(file string, keepDay int, now time.Time) []domain.ProcessEvent {
+	list := make([]domain.ProcessEvent, 0)
+	f, err := os.Open(file)
+	if err != nil {
+		return list
+	}
+	defer f.Close()
+
+	limit := now.AddDate(0, 0, -keepDay).UnixMilli()
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		e := domain.ProcessEvent{}
+		if json.Unmarshal(scanner.Bytes(), &e) != nil {
+			continue
+		}
+		if e.Time < limit {
+			continue
+		}
+		list = append(list, e)
+	}
+	return list
+}
+
+// compactJournal 보관 기간과 개수를 넘은 이벤트를 제거한다. 남은 이벤트 수를 반환한다
+func compactJournal(file string, keepCount, keepDay int, now time.Time) (int, error) {
+	list := readJournal(file, keepDay, now)
+	if len(list) > keepCount {
+		list = list[len(list)-keepCount:]
+	}
+
+	tmp := file + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return 0, fmt.Errorf("fail to create %s : %s", tmp, err.Error())
+	}
+	w := bufio.NewWriter(f)
+	for _, e := range list {
+		b, err := json.Marshal(e)
+		if err != nil {
+			continue
+		}
+		_, _ = w.Write(append(b, '\n'))
+	}
+	err = w.Flush()
+	f.Close()
+	if err != nil {
+		os.Remove(tmp)
+		return 0, fmt.Errorf("fail to write %s : %s", tmp, err.Error())
+	}
+	if err = os.Rename(tmp, file); err != nil {
+		os.Remove(tmp)
+		return 0, fmt.Errorf("fail to rename %s : %s", tmp, err.Error())
+	}
+	return len(list), nil
+}
+
+// filterProcessEvents 조건에 맞는 이벤트를 최신순으로 최대 limit 개 고른다
+func filterProcessEvents(list []domain.ProcessEvent, from, to int64, event string, limit int) []domain.ProcessEvent {
+	selected := make([]domain.ProcessEvent, 0)
+	for i := len(list) - 1; i >= 0; i-- {
+		e := list[i]
+		if from > 0 && e.Time < from {
+			continue
+		}
+		if to > 0 && e.Time > to {
+			continue
+		}
+		if len(event) > 0 && e.Event != event {
+			continue
+		}
+		selected = append(selected, e)
+		if limit > 0 && len(selected) >= limit {
+			break
+		}
+	}
+	return selected
+}
+
+func readProcessEvents(env fatima.FatimaEnv, proc string, from, to int64, event string, limit int) []domain.ProcessEvent {
+	journalMutex.Lock()
+	list := readJournal(buildJournalFile(env, proc), journalKeepDay, time.Now())
+	journalMutex.Unlock()
+	return filterProcessEvents(list, from, to, event, limit)
+}
+
+// ListProcessEvents 프로세스의 lifecycle 이벤트를 최신순으로 조회한다
+func (service *DomainService) ListProcessEvents(proc string, from, to int64, event string, limit int) ([]domain.ProcessEvent, error) {
+	if len(event) > 0 && !domain.IsValidProcessEvent(event) {
+		return nil, fmt.Errorf("invalid event : %s", event)
+	}
+
+	env := service.fatimaRuntime.GetEnv()
+	if builder.NewYamlFatimaPackageConfig(env).GetProcByName(proc) == nil {
+		if _, err := os.Stat(buildJournalFile(env, proc)); err != nil {
+			return nil, fmt.Errorf("not found process %s", proc)
+		}
+	}
+	return readProcessEvents(env, proc, from, to, event, limit), nil
+}
+
+func loadEventTimeline(ctx *ProcessReportContext) {
+	defer ctx.wg.Done()
+
+	ctx.report.Timeline = readProcessEvents(ctx.fatimaRuntime.GetEnv(), ctx.proc, 0, 0, "", reportTimelineLimit)
+}
@@ -0,0 +1,101 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 6:19
+ */
+
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/fatima-go/juno/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompactJournal(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "sample.log")
+	now := time.Now()
+
+	f, err := os.Create(file)
+	assert.Nil(t, err)
+	// 보관 기간이 지난 이벤트 1건과 최근 이벤트 5건
+	old := domain.ProcessEvent{Time: now.AddDate(0, 0, -10).UnixMilli(), Process: "sample", Event: domain.ProcessEventStarted}
+	b, _ := json.Marshal(old)
+	f.Write(append(b, '\n'))
+	for i := 0; i < 5; i++ {
+		e := domain.ProcessEvent{Time: now.UnixMilli() + int64(i), Process: "sample", Event: domain.ProcessEventStopped, Pid: i + 1}
+		b, _ = json.Marshal(e)
+		f.Write(append(b, '\n'))
+	}
+	f.Close()
+
+	assert.Equal(t, 5, len(readJournal(file, 7, now)))
+
+	kept, err := compactJournal(file, 3, 7, now)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, kept)
+	assert.Equal(t, 3, countJournalLines(file))
+
+	list := readJournal(file, 7, now)
+	assert.Equal(t, 3, list[0].Pid)
+	assert.Equal(t, 5, list[2].Pid)
+}
+
+func TestFilterProcessEvents(t *testing.T) {
+	list := []domain.ProcessEvent{
+		{Time: 1000, Event: domain.ProcessEventStarted},
+		{Time: 2000, Event: domain.ProcessEventCrashed},
+		{Time: 3000, Event: domain.ProcessEventRestarted},
+		{Time: 4000, Event: domain.ProcessEventCrashed},
+	}
+
+	// 최신순
+	selected := filterProcessEvents(list, 0, 0, "", 0)
+	assert.Equal(t, int64(4000), selected[0].Time)
+	assert.Equal(t, 4, len(selected))
+
+	selected = filterProcessEvents(list, 0, 0, domain.ProcessEventCrashed, 0)
+	assert.Equal(t, 2, len(selected))
+
+	selected = filterProcessEvents(list, 1500, 3500, "", 0)
+	assert.Equal(t, 2, len(selected))
+	assert.Equal(t, int64(3000), selected[0].Time)
+
+	selected = filterProcessEvents(list, 0, 0, "", 1)
+	assert.Equal(t, 1, len(selected))
+}
+
+func TestTrimLineFile(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "audit.log")
+	content := ""
+	for i := 0; i < 10; i++ {
+		content += fmt.Sprintf("line-%d\n", i)
+	}
+	assert.Nil(t, os.WriteFile(file, []byte(content), 0644))
+
+	assert.Nil(t, trimLineFile(file, 3))
+	b, err := os.ReadFile(file)
+	assert.Nil(t, err)
+	assert.Equal(t, "line-7\nline-8\nline-9\n", string(b))
+	assert.Equal(t, 3, countJournalLines(file))
+}
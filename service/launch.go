@@ -0,0 +1,340 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 5:27
+ */
+
+package service
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"syscall"
+
+	"github.com/fatima-go/fatima-core"
+	"github.com/fatima-go/fatima-core/builder"
+	"github.com/fatima-go/fatima-log"
+	"github.com/fatima-go/juno/domain"
+	"github.com/fatima-go/juno/infra"
+)
+
+const (
+	launchSpecDataDir = "launch"
+	defaultEnvFile    = ".env"
+)
+
+func buildLaunchSpecFile(env fatima.FatimaEnv, proc string) string {
+	return filepath.Join(env.GetFolderGuide().GetDataFolder(), launchSpecDataDir, proc+".json")
+}
+
+// readLaunchSpec read launch spec from $FATIMA_HOME/data/juno/launch/my_process.json
+func readLaunchSpec(env fatima.FatimaEnv, proc string) domain.LaunchSpec {
+	spec := domain.LaunchSpec{}
+	b, err := os.ReadFile(buildLaunchSpecFile(env, proc))
+	if err != nil {
+		return spec
+	}
+
+	err = json.Unmarshal(b, &spec)
+	if err != nil {
+		log.Warn("%s invalid launch spec : %s", proc, err.Error())
+		return domain.LaunchSpec{}
+	}
+	return spec
+}
+
+func writeLaunchSpec(env fatima.FatimaEnv, proc string, spec domain.LaunchSpec) error {
+	file := buildLaunchSpecFile(env, proc)
+	if spec.IsEmpty() {
+		err := os.Remove(file)
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+
+	err := os.MkdirAll(filepath.Dir(file), 0755)
+	if err != nil {
+		return fmt.Errorf("fail to make dir %s : %s", filepath.Dir(file), err.Error())
+	}
+
+	b, err := json.MarshalIndent(spec, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(file, b, 0644)
+}
+
+func removeLaunchSpec(env fatima.FatimaEnv, proc string) {
+	_ = os.Remove(buildLaunchSpecFile(env, proc))
+}
+
+func (service *DomainService) GetLaunchSpec(proc string) (domain.LaunchSpec, error) {
+	yamlConfig := builder.NewYamlFatimaPackageConfig(service.fatimaRuntime.GetEnv())
+	if yamlConfig.GetProcByName(proc) == nil {
+		return domain.LaunchSpec{}, fmt.Errorf("not found process %s", proc)
+	}
+
+	return readLaunchSpec(service.fatimaRuntime.GetEnv(), proc), nil
+}
+
+func (service *DomainService) UpdateLaunchSpec(proc string, spec domain.LaunchSpec) error {
+	log.Info("UpdateLaunchSpec. proc=[%s], spec=[%v]", proc, spec)
+
+	yamlConfig := builder.NewYamlFatimaPackageConfig(service.fatimaRuntime.GetEnv())
+	if yamlConfig.GetProcByName(proc) == nil {
+		return fmt.Errorf("not found process %s", proc)
+	}
+
+	err := spec.Validate()
+	if err != nil {
+		return err
+	}
+
+	credential, err := resolveLaunchCredential(spec)
+	if err != nil {
+		return err
+	}
+	if credential != nil && !credential.isCurrent() {
+		err = checkLaunchPrivilege(credential)
+		if err != nil {
+			return err
+		}
+		err = verifyCredentialAccess(service.fatimaRuntime.GetEnv(), proc, credential)
+		if err != nil {
+			return err
+		}
+	}
+
+	return writeLaunchSpec(service.fatimaRuntime.GetEnv(), proc, spec)
+}
+
+func getAppDir(env fatima.FatimaEnv, proc string) string {
+	return filepath.Join(env.GetFolderGuide().GetFatimaHome(), builder.FatimaFolderApp, proc)
+}
+
+// buildProgramCommand 프로세스 기동을 위한 exec.Cmd 를 생성한다 (launch spec 적용)
+func buildProgramCommand(env fatima.FatimaEnv, proc fatima.FatimaPkgProc, spec domain.LaunchSpec) *exec.Cmd {
+	appDir := getAppDir(env, proc.GetName())
+
+	var executing string
+	if hasExecutingShell(env, proc) {
+		executing = filepath.Join(appDir, proc.GetName()+".sh")
+	} else if len(proc.GetPath()) > 0 {
+		executing = proc.GetPath()
+	} else {
+		executing = filepath.Join(appDir, proc.GetName())
+	}
+
+	cmd := exec.Command(executing, spec.Args...)
+	cmd.Dir = appDir
+	if len(spec.WorkingDir) > 0 {
+		if filepath.IsAbs(spec.WorkingDir) {
+			cmd.Dir = spec.WorkingDir
+		} else {
+			cmd.Dir = filepath.Join(appDir, spec.WorkingDir)
+		}
+	}
+
+	cmd.Env = buildLaunchEnv(appDir, spec)
+	return cmd
+}
+
+// buildLaunchEnv juno 환경변수 위에 launch spec 에서 정의한 환경변수를 덮어쓴다
+func buildLaunchEnv(appDir string, spec domain.LaunchSpec) []string {
+	merged := make(map[string]string)
+	keys := make([]string, 0)
+	put := func(k, v string) {
+		if _, ok := merged[k]; !ok {
+			keys = append(keys, k)
+		}
+		merged[k] = v
+	}
+
+	for _, kv := range os.Environ() {
+		idx := strings.Index(kv, "=")
+		if idx < 1 {
+			continue
+		}
+		put(kv[:idx], kv[idx+1:])
+	}
+
+	for _, kv := range collectLaunchEnv(appDir, spec) {
+		put(kv[0], kv[1])
+	}
+
+	list := make([]string, 0, len(keys))
+	for _, k := range keys {
+		list = append(list, k+"="+merged[k])
+	}
+	return list
+}
+
+// collectLaunchEnv app 폴더의 .env 파일, env_files, spec 의 env 순서로 환경변수를 수집한다
+func collectLaunchEnv(appDir string, spec domain.LaunchSpec) [][2]string {
+	list := make([][2]string, 0)
+
+	envFiles := append([]string{defaultEnvFile}, spec.EnvFiles...)
+	for _, f := range envFiles {
+		path := f
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(appDir, f)
+		}
+		fileEnv, err := readEnvFile(path)
+		if err != nil {
+			if f != defaultEnvFile {
+				log.Warn("fail to read env file %s : %s", path, err.Error())
+			}
+			continue
+		}
+		list = append(list, fileEnv...)
+	}
+
+	specKeys := make([]string, 0, len(spec.Env))
+	for k := range spec.Env {
+		specKeys = append(specKeys, k)
+	}
+	sort.Strings(specKeys)
+	for _, k := range specKeys {
+		list = append(list, [2]string{k, spec.Env[k]})
+	}
+
+	return list
+}
+
+// readEnvFile parse dotenv style file. (KEY=VALUE, export KEY=VALUE, # comment)
+func readEnvFile(path string) ([][2]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	list := make([][2]string, 0)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) == 0 || strings.HasPrefix(line, "#") {
+			continue
+		}
+		line = strings.TrimPrefix(line, "export ")
+		idx := strings.Index(line, "=")
+		if idx < 1 {
+			continue
+		}
+		key := strings.TrimSpace(line[:idx])
+		value := strings.TrimSpace(line[idx+1:])
+		if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+			value = value[1 : len(value)-1]
+		} else if i := strings.Index(value, " #"); i >= 0 {
+			value = strings.TrimSpace(value[:i])
+		}
+		list = append(list, [2]string{key, value})
+	}
+
+	return list, scanner.Err()
+}
+
+// startCommand launch spec 의 umask, cgroup, rlimit 등을 적용하여 child 를 기동한다
+func startCommand(cmd *exec.Cmd, spec domain.LaunchSpec) error {
+	// cgroup 없이 기동되면 memory.max, cpu.max 가 적용되지 않으므로 기동을 거부한다
+	release, err := infra.PrepareLaunchResource(cmd, spec.Resource)
+	defer release()
+	if err != nil {
+		return fmt.Errorf("fail to prepare launch resource : %s", err.Error())
+	}
+
+	helper := infra.LaunchHelperSpec{}
+	if mask, ok := spec.GetUmask(); ok {
+		helper.Umask = &mask
+	}
+	if !spec.Resource.IsEmpty() {
+		resource := spec.Resource
+		helper.Resource = &resource
+	}
+	confirm, err := infra.WrapLaunchCommand(cmd, helper)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.Start()
+	if err != nil {
+		_ = confirm(false)
+		return err
+	}
+	// helper 가 리소스를 적용하지 못했다면 target 은 exec 되지 않는다
+	err = confirm(true)
+	if err != nil {
+		// reaper 는 등록된 child 만 회수하므로 여기서 정리하지 않으면 zombie 로 남는다.
+		// 확인 시간이 초과된 경우에도 이후에 추적되지 않는 target 이 exec 되지 않도록 group 전체를 종료한다
+		abandonCommand(cmd)
+		return err
+	}
+	return nil
+}
+
+// abandonCommand 기동에 실패한 child 를 종료하고 회수한다
+func abandonCommand(cmd *exec.Cmd) {
+	pid := cmd.Process.Pid
+	if cmd.SysProcAttr != nil && cmd.SysProcAttr.Setpgid {
+		_ = syscall.Kill(-pid, syscall.SIGKILL)
+	} else {
+		_ = cmd.Process.Kill()
+	}
+	_ = cmd.Wait()
+}
+
+// formatCommandLine 실제 실행될 명령어를 사람이 읽을 수 있는 형태로 표현
+func formatCommandLine(cmd *exec.Cmd) string {
+	list := make([]string, 0, len(cmd.Args))
+	for _, arg := range cmd.Args {
+		if len(arg) == 0 || strings.ContainsAny(arg, " \t\"'") {
+			arg = fmt.Sprintf("%q", arg)
+		}
+		list = append(list, arg)
+	}
+	return strings.Join(list, " ")
+}
+
+func buildLaunchInfo(env fatima.FatimaEnv, proc fatima.FatimaPkgProc, pid int) domain.LaunchInfo {
+	spec := readLaunchSpec(env, proc.GetName())
+	cmd := buildProgramCommand(env, proc, spec)
+
+	info := domain.LaunchInfo{}
+	info.CommandLine = formatCommandLine(cmd)
+	info.WorkingDir = cmd.Dir
+	info.Umask = spec.Umask
+	info.User = spec.User
+	info.Group = spec.Group
+	info.EnvKeys = make([]string, 0)
+	seen := make(map[string]struct{})
+	for _, kv := range collectLaunchEnv(getAppDir(env, proc.GetName()), spec) {
+		if _, ok := seen[kv[0]]; ok {
+			continue
+		}
+		seen[kv[0]] = struct{}{}
+		info.EnvKeys = append(info.EnvKeys, kv[0])
+	}
+	info.Resource = infra.ReadProcessResource(pid)
+	return info
+}
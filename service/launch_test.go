@@ -0,0 +1,77 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 5:27
+ */
+
+package service
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/fatima-go/juno/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCollectLaunchEnv(t *testing.T) {
+	appDir := t.TempDir()
+	dotenv := "# comment\n" +
+		"export APP_MODE=prod\n" +
+		"QUOTED=\"hello world\"\n" +
+		"TRAILING=value # comment\n" +
+		"invalid line\n"
+	assert.Nil(t, os.WriteFile(filepath.Join(appDir, ".env"), []byte(dotenv), 0644))
+	assert.Nil(t, os.WriteFile(filepath.Join(appDir, "extra.env"), []byte("APP_MODE=stage\n"), 0644))
+
+	spec := domain.LaunchSpec{}
+	spec.EnvFiles = []string{"extra.env"}
+	spec.Env = map[string]string{"B": "2", "A": "1"}
+
+	list := collectLaunchEnv(appDir, spec)
+	assert.Equal(t, [][2]string{
+		{"APP_MODE", "prod"},
+		{"QUOTED", "hello world"},
+		{"TRAILING", "value"},
+		{"APP_MODE", "stage"},
+		{"A", "1"},
+		{"B", "2"},
+	}, list)
+}
+
+func TestLaunchSpecValidate(t *testing.T) {
+	assert.Nil(t, domain.LaunchSpec{Umask: "022"}.Validate())
+	assert.NotNil(t, domain.LaunchSpec{Umask: "999"}.Validate())
+	assert.NotNil(t, domain.LaunchSpec{Env: map[string]string{"A=B": "1"}}.Validate())
+	assert.NotNil(t, domain.LaunchSpec{EnvFiles: []string{"../secret.env"}}.Validate())
+
+	mask, ok := domain.LaunchSpec{Umask: "027"}.GetUmask()
+	assert.True(t, ok)
+	assert.Equal(t, 027, mask)
+}
+
+func TestStartCommandAbandonOnHelperFailure(t *testing.T) {
+	// helper 가 리소스를 적용하지 못하면 child 를 회수하여 zombie 가 남지 않는다
+	cmd := exec.Command("/bin/sleep", "5")
+	setProcessGroup(cmd)
+	invalid := int64(1) << 40
+	err := startCommand(cmd, domain.LaunchSpec{Resource: domain.ResourceSpec{RlimitNoFile: &invalid}})
+	assert.NotNil(t, err)
+	assert.NotNil(t, cmd.ProcessState)
+}
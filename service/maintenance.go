@@ -0,0 +1,223 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 5:39
+ */
+
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fatima-go/fatima-core"
+	"github.com/fatima-go/fatima-core/builder"
+	"github.com/fatima-go/fatima-log"
+	"github.com/fatima-go/juno/domain"
+	"github.com/fatima-go/juno/web"
+)
+
+const (
+	maintenanceDataFile = "maintenance.json"
+	maxMaintenanceTime  = 7 * 24 * time.Hour
+)
+
+var (
+	maintenanceMutex sync.Mutex
+	// maintenances maintenance.json 의 내용. nil 이면 아직 읽지 않은 상태 (maintenanceMutex 로 보호)
+	maintenances map[string]domain.Maintenance
+)
+
+func buildMaintenanceFile(env fatima.FatimaEnv) string {
+	return filepath.Join(env.GetFolderGuide().GetDataFolder(), maintenanceDataFile)
+}
+
+// readMaintenances 만료된 항목은 제외한 복사본을 리턴한다. 파일은 처음 한번만 읽는다
+// maintenanceMutex 를 잡은 상태에서 호출해야 한다
+func readMaintenances(env fatima.FatimaEnv) map[string]domain.Maintenance {
+	if maintenances == nil {
+		maintenances = loadMaintenanceFile(env)
+	}
+
+	now := time.Now().UnixMilli()
+	m := make(map[string]domain.Maintenance, len(maintenances))
+	for k, v := range maintenances {
+		if !v.IsExpired(now) {
+			m[k] = v
+		}
+	}
+	return m
+}
+
+func loadMaintenanceFile(env fatima.FatimaEnv) map[string]domain.Maintenance {
+	m := make(map[string]domain.Maintenance)
+	b, err := os.ReadFile(buildMaintenanceFile(env))
+	if err != nil {
+		return m
+	}
+
+	list := make([]domain.Maintenance, 0)
+	err = json.Unmarshal(b, &list)
+	if err != nil {
+		log.Warn("invalid maintenance file : %s", err.Error())
+		return m
+	}
+
+	for _, v := range list {
+		m[v.Key()] = v
+	}
+	return m
+}
+
+func writeMaintenances(env fatima.FatimaEnv, m map[string]domain.Maintenance) error {
+	list := make([]domain.Maintenance, 0, len(m))
+	for _, v := range m {
+		list = append(list, v)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Key() < list[j].Key() })
+
+	b, err := json.MarshalIndent(list, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	file := buildMaintenanceFile(env)
+	err = os.MkdirAll(filepath.Dir(file), 0755)
+	if err != nil {
+		return fmt.Errorf("fail to make dir %s : %s", filepath.Dir(file), err.Error())
+	}
+	err = os.WriteFile(file, b, 0644)
+	if err != nil {
+		return err
+	}
+	maintenances = m
+	return nil
+}
+
+// findMaintenance 프로세스 혹은 프로세스가 속한 그룹이 점검 모드라면 해당 정보를 리턴한다
+func findMaintenance(env fatima.FatimaEnv, proc string, group string) (domain.Maintenance, bool) {
+	maintenanceMutex.Lock()
+	defer maintenanceMutex.Unlock()
+
+	m := readMaintenances(env)
+	if v, ok := m[domain.MaintenanceTargetProcess+":"+proc]; ok {
+		return v, true
+	}
+	if len(group) > 0 {
+		if v, ok := m[domain.MaintenanceTargetGroup+":"+group]; ok {
+			return v, true
+		}
+	}
+	return domain.Maintenance{}, false
+}
+
+func (service *DomainService) SetMaintenance(targetType string, target string, duration string, reason string) error {
+	env := service.fatimaRuntime.GetEnv()
+	yamlConfig := builder.NewYamlFatimaPackageConfig(env)
+
+	switch targetType {
+	case domain.MaintenanceTargetProcess:
+		if yamlConfig.GetProcByName(target) == nil {
+			return fmt.Errorf("not found process %s", target)
+		}
+	case domain.MaintenanceTargetGroup:
+		if yamlConfig.GetGroupId(target) < 0 {
+			return fmt.Errorf("not found group %s", target)
+		}
+	default:
+		return fmt.Errorf("invalid maintenance target type : %s", targetType)
+	}
+
+	d, err := time.ParseDuration(duration)
+	if err != nil {
+		return fmt.Errorf("invalid duration %s : %s", duration, err.Error())
+	}
+	if d <= 0 || d > maxMaintenanceTime {
+		return fmt.Errorf("duration must be between 0 and %s", maxMaintenanceTime)
+	}
+
+	now := time.Now().UnixMilli()
+	item := domain.Maintenance{
+		TargetType: targetType,
+		Target:     target,
+		Until:      now + d.Milliseconds(),
+		Reason:     reason,
+		Created:    now,
+	}
+
+	maintenanceMutex.Lock()
+	defer maintenanceMutex.Unlock()
+	m := readMaintenances(env)
+	m[item.Key()] = item
+	log.Warn("set maintenance %s until %s : %s", item.Key(), time.UnixMilli(item.Until).Format(web.TIME_YYYYMMDDHHMMSS), reason)
+	return writeMaintenances(env, m)
+}
+
+func (service *DomainService) ClearMaintenance(targetType string, target string) error {
+	env := service.fatimaRuntime.GetEnv()
+
+	maintenanceMutex.Lock()
+	defer maintenanceMutex.Unlock()
+	m := readMaintenances(env)
+	key := targetType + ":" + target
+	if _, ok := m[key]; !ok {
+		return fmt.Errorf("not found maintenance %s", key)
+	}
+	delete(m, key)
+	log.Warn("clear maintenance %s", key)
+	return writeMaintenances(env, m)
+}
+
+func (service *DomainService) ListMaintenance() []domain.Maintenance {
+	maintenanceMutex.Lock()
+	defer maintenanceMutex.Unlock()
+
+	m := readMaintenances(service.fatimaRuntime.GetEnv())
+	list := make([]domain.Maintenance, 0, len(m))
+	for _, v := range m {
+		list = append(list, v)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Key() < list[j].Key() })
+	return list
+}
+
+// fillMaintenance package report 에 점검 모드 만료 시각을 표시한다
+func fillMaintenance(env fatima.FatimaEnv, processes []domain.ProcessInfo, loc *time.Location) {
+	maintenanceMutex.Lock()
+	m := readMaintenances(env)
+	maintenanceMutex.Unlock()
+	if len(m) == 0 {
+		return
+	}
+
+	for i := range processes {
+		v, ok := m[domain.MaintenanceTargetProcess+":"+processes[i].Name]
+		if !ok {
+			v, ok = m[domain.MaintenanceTargetGroup+":"+processes[i].Group]
+		}
+		if ok {
+			processes[i].Maintenance = strings.TrimSpace(fmt.Sprintf("until %s %s",
+				time.UnixMilli(v.Until).In(loc).Format(web.TIME_YYYYMMDDHHMMSS), v.Reason))
+		}
+	}
+}
@@ -0,0 +1,352 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 6:07
+ */
+
+package service
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/fatima-go/fatima-core"
+	"github.com/fatima-go/fatima-core/builder"
+	"github.com/fatima-go/fatima-log"
+	"github.com/fatima-go/juno/domain"
+)
+
+// 프로세스별 metric 은 data/metrics/<proc>/<tier>.ring 에 고정 크기 ring 으로 저장한다
+// 파일 구조 : header(16) + slot * record(56)
+// header : magic(4) step(4) slots(4) reserved(4)
+// record : bucket(8, unix sec) + metric(cpu, rss, fd, thread) 별 count(4) avg(4, float32) max(4, float32)
+const (
+	metricDataDir      = "metrics"
+	metricMagic        = "JMR1"
+	metricHeaderSize   = 16
+	metricFieldSize    = 12
+	metricRecordSize   = 8 + metricFieldSize*4
+	maxMetricPoints    = 1500
+	defaultMetricRange = time.Hour
+	metricRawRange     = time.Hour
+	legacyMetricRing   = "1s.ring"
+)
+
+// metricTier 해상도별 보관 구간. scan 주기/1시간, 1m/24시간, 10m/30일
+type metricTier struct {
+	name  string
+	step  int64 // sec
+	slots int64
+}
+
+// 측정은 scan 마다 이루어지므로 가장 세밀한 tier 의 step 은 scan 주기와 같다. configureMetricTiers 참고
+var metricTiers = []metricTier{
+	{name: "raw", step: defaultMonitorPollSec, slots: int64(metricRawRange/time.Second) / defaultMonitorPollSec},
+	{name: "1m", step: 60, slots: 1440},
+	{name: "10m", step: 600, slots: 4320},
+}
+
+var metricOrder = []string{domain.MetricCpu, domain.MetricRss, domain.MetricFd, domain.MetricThread}
+
+var metricMutex sync.Mutex
+
+type metricField struct {
+	count uint32
+	avg   float32
+	max   float32
+}
+
+type metricRecord struct {
+	bucket int64
+	fields [4]metricField
+}
+
+func (r *metricRecord) encode(b []byte) {
+	binary.LittleEndian.PutUint64(b[0:], uint64(r.bucket))
+	for i, f := range r.fields {
+		off := 8 + i*metricFieldSize
+		binary.LittleEndian.PutUint32(b[off:], f.count)
+		binary.LittleEndian.PutUint32(b[off+4:], math.Float32bits(f.avg))
+		binary.LittleEndian.PutUint32(b[off+8:], math.Float32bits(f.max))
+	}
+}
+
+func decodeMetricRecord(b []byte) metricRecord {
+	r := metricRecord{bucket: int64(binary.LittleEndian.Uint64(b[0:]))}
+	for i := range r.fields {
+		off := 8 + i*metricFieldSize
+		r.fields[i].count = binary.LittleEndian.Uint32(b[off:])
+		r.fields[i].avg = math.Float32frombits(binary.LittleEndian.Uint32(b[off+4:]))
+		r.fields[i].max = math.Float32frombits(binary.LittleEndian.Uint32(b[off+8:]))
+	}
+	return r
+}
+
+// merge 측정되지 않은(음수) 값은 제외하고 평균과 최대값을 갱신한다
+func (r *metricRecord) merge(values [4]float64) {
+	for i, v := range values {
+		if v < 0 {
+			continue
+		}
+		f := &r.fields[i]
+		f.avg = float32((float64(f.avg)*float64(f.count) + v) / float64(f.count+1))
+		if f.count == 0 || float32(v) > f.max {
+			f.max = float32(v)
+		}
+		f.count++
+	}
+}
+
+func metricValues(m domain.ProcessMetric) [4]float64 {
+	return [4]float64{m.CpuPercent, float64(m.RssKb), float64(m.FDCount), float64(m.Threads)}
+}
+
+func metricIndex(metric string) int {
+	for i, v := range metricOrder {
+		if v == metric {
+			return i
+		}
+	}
+	return -1
+}
+
+func buildMetricDir(env fatima.FatimaEnv, proc string) string {
+	return filepath.Join(env.GetFolderGuide().GetDataFolder(), metricDataDir, proc)
+}
+
+func (t metricTier) fileSize() int64 {
+	return metricHeaderSize + t.slots*metricRecordSize
+}
+
+func (t metricTier) offset(bucket int64) int64 {
+	return metricHeaderSize + ((bucket/t.step)%t.slots)*metricRecordSize
+}
+
+// openMetricRing ring 파일을 연다. 없거나 tier 구성이 다르면 새로 만든다
+func openMetricRing(dir string, tier metricTier, create bool) (*os.File, error) {
+	file := filepath.Join(dir, tier.name+".ring")
+	flag := os.O_RDWR
+	if create {
+		flag |= os.O_CREATE
+	}
+	f, err := os.OpenFile(file, flag, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	header := make([]byte, metricHeaderSize)
+	_, err = f.ReadAt(header, 0)
+	valid := err == nil && string(header[:4]) == metricMagic &&
+		int64(binary.LittleEndian.Uint32(header[4:])) == tier.step &&
+		int64(binary.LittleEndian.Uint32(header[8:])) == tier.slots
+	if stat, e := f.Stat(); valid && e == nil && stat.Size() == tier.fileSize() {
+		return f, nil
+	}
+	if !create {
+		_ = f.Close()
+		return nil, fmt.Errorf("invalid metric ring %s", file)
+	}
+
+	copy(header, metricMagic)
+	binary.LittleEndian.PutUint32(header[4:], uint32(tier.step))
+	binary.LittleEndian.PutUint32(header[8:], uint32(tier.slots))
+	binary.LittleEndian.PutUint32(header[12:], 0)
+	err = f.Truncate(0)
+	if err == nil {
+		err = f.Truncate(tier.fileSize())
+	}
+	if err == nil {
+		_, err = f.WriteAt(header, 0)
+	}
+	if err != nil {
+		_ = f.Close()
+		return nil, fmt.Errorf("fail to init metric ring %s : %s", file, err.Error())
+	}
+	return f, nil
+}
+
+// writeMetricSample 시각이 속하는 slot 에 값을 합친다. slot 이 이전 주기의 것이면 덮어쓴다
+func writeMetricSample(f *os.File, tier metricTier, sec int64, values [4]float64) error {
+	bucket := sec - sec%tier.step
+	off := tier.offset(bucket)
+	b := make([]byte, metricRecordSize)
+	if _, err := f.ReadAt(b, off); err != nil && err != io.EOF {
+		return err
+	}
+	record := decodeMetricRecord(b)
+	if record.bucket != bucket {
+		record = metricRecord{bucket: bucket}
+	}
+	record.merge(values)
+	record.encode(b)
+	_, err := f.WriteAt(b, off)
+	return err
+}
+
+// configureMetricTiers 가장 세밀한 tier 의 step 을 scan 주기에 맞춘다.
+// scan 주기보다 촘촘한 bucket 은 대부분 비어 있어 API 가 알려주는 step 과 실제 간격이 달라진다
+func configureMetricTiers(env fatima.FatimaEnv, interval time.Duration) {
+	step := int64(interval / time.Second)
+	if step < 1 {
+		step = 1
+	}
+	if step > metricTiers[1].step {
+		step = metricTiers[1].step
+	}
+
+	metricMutex.Lock()
+	defer metricMutex.Unlock()
+	metricTiers[0] = metricTier{name: "raw", step: step, slots: int64(metricRawRange/time.Second) / step}
+
+	// 1초 고정 tier 를 사용하던 때의 파일은 더 이상 갱신되지 않는다
+	legacy, _ := filepath.Glob(filepath.Join(env.GetFolderGuide().GetDataFolder(), metricDataDir, "*", legacyMetricRing))
+	for _, file := range legacy {
+		_ = os.Remove(file)
+	}
+	log.Info("metric raw tier step : %d sec", step)
+}
+
+// recordProcessMetrics scan 마다 실행중인 프로세스의 측정값을 모든 tier 에 기록한다
+func recordProcessMetrics(env fatima.FatimaEnv, processes []*domain.ProcessInfo, now time.Time) {
+	metricMutex.Lock()
+	defer metricMutex.Unlock()
+
+	sec := now.Unix()
+	for _, item := range processes {
+		if !item.IsRunning() || item.Metric == nil {
+			continue
+		}
+		dir := buildMetricDir(env, item.Name)
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			log.Warn("fail to make metric dir %s : %s", dir, err.Error())
+			continue
+		}
+		values := metricValues(*item.Metric)
+		for _, tier := range metricTiers {
+			f, err := openMetricRing(dir, tier, true)
+			if err != nil {
+				log.Warn("%s", err.Error())
+				continue
+			}
+			err = writeMetricSample(f, tier, sec, values)
+			_ = f.Close()
+			if err != nil {
+				log.Warn("fail to write %s metric : %s", item.Name, err.Error())
+			}
+		}
+	}
+}
+
+func removeProcessMetrics(env fatima.FatimaEnv, proc string) {
+	metricMutex.Lock()
+	defer metricMutex.Unlock()
+	_ = os.RemoveAll(buildMetricDir(env, proc))
+}
+
+// selectMetricTier from 을 보관하고 있으면서 point 수가 너무 많지 않은 가장 세밀한 tier
+func selectMetricTier(now, from, to int64) metricTier {
+	for _, tier := range metricTiers {
+		if now-from > tier.step*tier.slots {
+			continue
+		}
+		if (to-from)/tier.step > maxMetricPoints {
+			continue
+		}
+		return tier
+	}
+	return metricTiers[len(metricTiers)-1]
+}
+
+// readMetricSeries [from, to] 구간의 point 를 시간순으로 리턴한다 (단위 unix sec)
+func readMetricSeries(dir string, tier metricTier, index int, from, to int64) ([]domain.MetricPoint, error) {
+	points := make([]domain.MetricPoint, 0)
+	f, err := openMetricRing(dir, tier, false)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return points, nil
+		}
+		return points, err
+	}
+	defer f.Close()
+
+	data := make([]byte, tier.fileSize())
+	if _, err = f.ReadAt(data, 0); err != nil && err != io.EOF {
+		return points, err
+	}
+
+	start := from - from%tier.step
+	if to-start > tier.step*tier.slots {
+		start = to - to%tier.step - tier.step*(tier.slots-1)
+	}
+	for bucket := start; bucket <= to; bucket += tier.step {
+		off := tier.offset(bucket)
+		record := decodeMetricRecord(data[off : off+metricRecordSize])
+		if record.bucket != bucket {
+			continue
+		}
+		field := record.fields[index]
+		if field.count == 0 {
+			continue
+		}
+		points = append(points, domain.MetricPoint{Time: bucket * 1000, Avg: float64(field.avg), Max: float64(field.max)})
+	}
+	return points, nil
+}
+
+// GetMetricSeries from, to 는 unix millis. 0 이면 최근 1시간
+func (service *DomainService) GetMetricSeries(proc string, metric string, from int64, to int64) (domain.MetricSeries, error) {
+	series := domain.MetricSeries{Process: proc, Metric: metric}
+	env := service.fatimaRuntime.GetEnv()
+	if builder.NewYamlFatimaPackageConfig(env).GetProcByName(proc) == nil {
+		return series, fmt.Errorf("not found process %s", proc)
+	}
+	index := metricIndex(metric)
+	if index < 0 {
+		return series, fmt.Errorf("invalid metric %s", metric)
+	}
+
+	now := time.Now()
+	if to <= 0 {
+		to = now.UnixMilli()
+	}
+	if from <= 0 {
+		from = to - defaultMetricRange.Milliseconds()
+	}
+	if from > to {
+		return series, fmt.Errorf("invalid range : from %d is after to %d", from, to)
+	}
+
+	metricMutex.Lock()
+	defer metricMutex.Unlock()
+
+	tier := selectMetricTier(now.Unix(), from/1000, to/1000)
+	series.StepSec = int(tier.step)
+	series.From = from
+	series.To = to
+	points, err := readMetricSeries(buildMetricDir(env, proc), tier, index, from/1000, to/1000)
+	if err != nil {
+		return series, fmt.Errorf("fail to read metric : %s", err.Error())
+	}
+	series.Points = points
+	return series, nil
+}
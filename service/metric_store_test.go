@@ -0,0 +1,90 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 6:07
+ */
+
+package service
+
+import (
+	"os"
+	"testing"
+
+	"github.com/fatima-go/juno/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetricRing(t *testing.T) {
+	dir := t.TempDir()
+	tier := metricTier{name: "test", step: 60, slots: 10}
+
+	f, err := openMetricRing(dir, tier, true)
+	assert.Nil(t, err)
+	base := int64(1760886000) // 60 의 배수
+	assert.Nil(t, writeMetricSample(f, tier, base+1, [4]float64{10, 1000, 5, -1}))
+	assert.Nil(t, writeMetricSample(f, tier, base+30, [4]float64{30, 3000, 7, -1}))
+	assert.Nil(t, writeMetricSample(f, tier, base+60, [4]float64{50, 2000, 9, 4}))
+	// slot 이 한바퀴 돌면 이전 주기의 값은 덮어쓴다
+	assert.Nil(t, writeMetricSample(f, tier, base+600, [4]float64{70, 500, 1, 2}))
+	_ = f.Close()
+
+	index := metricIndex(domain.MetricCpu)
+	points, err := readMetricSeries(dir, tier, index, base, base+600)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(points))
+	assert.Equal(t, (base+60)*1000, points[0].Time)
+	assert.Equal(t, float64(50), points[0].Avg)
+	assert.Equal(t, float64(70), points[1].Avg)
+
+	points, _ = readMetricSeries(dir, tier, index, base, base+59)
+	assert.Equal(t, 0, len(points))
+
+	// 측정되지 않은 값은 point 를 만들지 않는다
+	points, _ = readMetricSeries(dir, tier, metricIndex(domain.MetricThread), base+60, base+120)
+	assert.Equal(t, 1, len(points))
+
+	// tier 구성이 바뀌면 새로 만든다
+	other := metricTier{name: "test", step: 60, slots: 20}
+	f, err = openMetricRing(dir, other, true)
+	assert.Nil(t, err)
+	stat, _ := f.Stat()
+	assert.Equal(t, other.fileSize(), stat.Size())
+	_ = f.Close()
+	points, _ = readMetricSeries(dir, other, index, base, base+600)
+	assert.Equal(t, 0, len(points))
+
+	_, err = openMetricRing(t.TempDir(), tier, false)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestMetricRecordMerge(t *testing.T) {
+	record := metricRecord{bucket: 60}
+	record.merge([4]float64{10, 100, -1, 1})
+	record.merge([4]float64{30, 50, -1, 3})
+	assert.Equal(t, float32(20), record.fields[0].avg)
+	assert.Equal(t, float32(30), record.fields[0].max)
+	assert.Equal(t, float32(100), record.fields[1].max)
+	assert.Equal(t, uint32(0), record.fields[2].count)
+}
+
+func TestSelectMetricTier(t *testing.T) {
+	now := int64(1760886000)
+	assert.Equal(t, "raw", selectMetricTier(now, now-600, now).name)
+	assert.Equal(t, "1m", selectMetricTier(now, now-7200, now).name)
+	assert.Equal(t, "1m", selectMetricTier(now, now-3*3600, now-2*3600).name)
+	assert.Equal(t, "10m", selectMetricTier(now, now-7*86400, now).name)
+}
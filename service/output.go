@@ -0,0 +1,235 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 5:34
+ */
+
+package service
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/fatima-go/fatima-core"
+	"github.com/fatima-go/fatima-core/builder"
+	"github.com/fatima-go/fatima-log"
+	"github.com/fatima-go/juno/domain"
+)
+
+const (
+	// native 프로세스의 stdout/stderr 를 proc 폴더에 <proc>.<pid>.output 으로 저장할지 여부
+	propOutputCaptureEnable = "output.capture.enable"
+	// output 파일 rotate 기준 크기 (MB)
+	propOutputRotateSize    = "output.rotate.size"
+	defaultOutputRotateSize = 10
+	// pid 별로 유지할 rotate 된 파일 개수 (<proc>.<pid>.output.1 ~ N)
+	propOutputRotateCount    = "output.rotate.count"
+	defaultOutputRotateCount = 3
+	// 다른 pid 의 output 파일들을 유지하는 기간 (일)
+	propOutputKeepDay    = "output.keep.day"
+	defaultOutputKeepDay = 7
+)
+
+type outputCaptureConfig struct {
+	enable      bool
+	rotateSize  int64
+	rotateCount int
+	keepDay     int
+}
+
+var outputCapture = outputCaptureConfig{
+	enable:      true,
+	rotateSize:  defaultOutputRotateSize * 1024 * 1024,
+	rotateCount: defaultOutputRotateCount,
+	keepDay:     defaultOutputKeepDay,
+}
+
+func loadOutputCaptureConfig(config fatima.Config) {
+	if v, err := config.GetBool(propOutputCaptureEnable); err == nil {
+		outputCapture.enable = v
+	}
+	if v, err := config.GetInt(propOutputRotateSize); err == nil && v > 0 {
+		outputCapture.rotateSize = int64(v) * 1024 * 1024
+	}
+	if v, err := config.GetInt(propOutputRotateCount); err == nil && v >= 0 {
+		outputCapture.rotateCount = v
+	}
+	if v, err := config.GetInt(propOutputKeepDay); err == nil && v > 0 {
+		outputCapture.keepDay = v
+	}
+}
+
+func getProcDir(env fatima.FatimaEnv, proc string) string {
+	return filepath.Join(getAppDir(env, proc), builder.FatimaFolderProc)
+}
+
+func buildOutputFile(procDir, proc string, pid int) string {
+	return filepath.Join(procDir, fmt.Sprintf("%s.%d.output", proc, pid))
+}
+
+// prepareOutputCapture child 의 stdout/stderr 를 proc 폴더의 파일에 직접 연결한다 (O_APPEND)
+// juno 가 종료되거나 재기동되어도 child 의 write 가 실패하지 않도록 juno 가 읽는 pipe 를 사용하지 않는다.
+// pid 는 기동 이후에 알 수 있으므로 임시 이름으로 생성하고 startOutputCapture 에서 연결한다
+func prepareOutputCapture(env fatima.FatimaEnv, proc string, cmd *exec.Cmd) (*os.File, error) {
+	if !outputCapture.enable {
+		return nil, nil
+	}
+
+	procDir := getProcDir(env, proc)
+	_ = os.MkdirAll(procDir, 0755)
+	stripOutputFiles(procDir, proc, outputCapture.keepDay)
+
+	path := filepath.Join(procDir, fmt.Sprintf("%s.starting.%d.output", proc, time.Now().UnixNano()))
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("fail to create output file : %s", err.Error())
+	}
+	cmd.Stdout = file
+	cmd.Stderr = file
+	return file, nil
+}
+
+// startOutputCapture 기동된 child 의 output 파일을 <proc>.<pid>.output 으로 연결한다
+// fatima-core 프로세스는 기동 중 같은 이름의 파일을 직접 만들므로 이미 존재하면 덮어쓰지 않는다.
+// child 는 fd 를 상속 받았으므로 juno 쪽 fd 는 닫는다
+func startOutputCapture(env fatima.FatimaEnv, proc string, cmd *exec.Cmd, file *os.File) {
+	if file == nil {
+		return
+	}
+	defer file.Close()
+
+	if cmd.Process == nil {
+		_ = os.Remove(file.Name())
+		return
+	}
+
+	linkOutputFile(proc, file.Name(), buildOutputFile(getProcDir(env, proc), proc, cmd.Process.Pid))
+}
+
+// linkOutputFile 임시 파일을 path 로 연결한다. path 가 이미 있으면 그대로 두고 임시 파일만 삭제한다
+func linkOutputFile(proc, temp, path string) {
+	err := os.Link(temp, path)
+	if err != nil && !os.IsExist(err) {
+		log.Warn("[%s] fail to link output file : %s", proc, err.Error())
+		return
+	}
+	if err != nil {
+		log.Debug("[%s] output file is already created by process : %s", proc, path)
+	}
+	_ = os.Remove(temp)
+}
+
+// matchOutputFile <proc>.<pid>.output 이면 live, <proc>.<pid>.output.N 이면 rotated
+func matchOutputFile(base, proc string) (live bool, ok bool) {
+	rest := strings.TrimPrefix(base, proc+".")
+	if rest == base {
+		return false, false
+	}
+	parts := strings.Split(rest, ".")
+	if len(parts) < 2 || len(parts) > 3 || !isDigits(parts[0]) || parts[1] != "output" {
+		return false, false
+	}
+	if len(parts) == 3 {
+		return false, isDigits(parts[2])
+	}
+	return true, true
+}
+
+func isDigits(s string) bool {
+	if len(s) == 0 {
+		return false
+	}
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// listOutputFiles 프로세스의 output 파일 목록. 이름이 같은 prefix 로 시작하는 다른 프로세스의 파일은 제외한다
+func listOutputFiles(procDir, proc string, includeRotated bool) []string {
+	files, err := filepath.Glob(filepath.Join(procDir, proc+".*.output*"))
+	if err != nil {
+		return nil
+	}
+	list := make([]string, 0, len(files))
+	for _, f := range files {
+		live, ok := matchOutputFile(filepath.Base(f), proc)
+		if ok && (live || includeRotated) {
+			list = append(list, f)
+		}
+	}
+	return list
+}
+
+// stripOutputFiles 보관 기간이 지난 output 파일들을 삭제한다
+func stripOutputFiles(procDir, proc string, keepDay int) {
+	expire := time.Now().AddDate(0, 0, -keepDay)
+	for _, f := range listOutputFiles(procDir, proc, true) {
+		fi, err := os.Stat(f)
+		if err != nil || fi.ModTime().After(expire) {
+			continue
+		}
+		log.Info("remove expired output file : %s", f)
+		_ = os.Remove(f)
+	}
+}
+
+// rotateOutputFiles child 가 직접 기록하는 output 파일이 rotate 크기를 넘으면 copytruncate 방식으로 rotate 한다
+func rotateOutputFiles(env fatima.FatimaEnv, processes []*domain.ProcessInfo) {
+	if !outputCapture.enable || outputCapture.rotateSize <= 0 {
+		return
+	}
+
+	for _, item := range processes {
+		if !item.IsRunning() {
+			continue
+		}
+		for _, f := range listOutputFiles(getProcDir(env, item.Name), item.Name, false) {
+			fi, err := os.Stat(f)
+			if err != nil || fi.Size() <= outputCapture.rotateSize {
+				continue
+			}
+			if err = copyTruncateFile(f, outputCapture.rotateCount); err != nil {
+				log.Warn("[%s] fail to rotate output file : %s", item.Name, err.Error())
+			}
+		}
+	}
+}
+
+// copyTruncateFile file -> file.1 -> file.2 ... 순서로 보관하고 원본은 0 으로 truncate 한다
+// child 는 O_APPEND 로 기록하므로 truncate 이후에는 파일의 처음부터 기록된다.
+// copy 와 truncate 사이에 기록된 내용은 유실될 수 있다
+func copyTruncateFile(path string, maxCount int) error {
+	if maxCount > 0 {
+		_ = os.Remove(fmt.Sprintf("%s.%d", path, maxCount))
+		for i := maxCount - 1; i > 0; i-- {
+			_ = os.Rename(fmt.Sprintf("%s.%d", path, i), fmt.Sprintf("%s.%d", path, i+1))
+		}
+
+		err := copyFile(path, path+".1")
+		if err != nil {
+			return err
+		}
+	}
+	return os.Truncate(path, 0)
+}
@@ -0,0 +1,88 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 5:34
+ */
+
+package service
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCopyTruncateFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sample.100.output")
+	// child 와 동일하게 O_APPEND 로 열어둔 상태에서 rotate 한다
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	assert.Nil(t, err)
+	defer file.Close()
+
+	for _, s := range []string{"aaaaaaaa\n", "bbbbbbbb\n", "cccccccc\n"} {
+		_, err = file.WriteString(s)
+		assert.Nil(t, err)
+		assert.Nil(t, copyTruncateFile(path, 2))
+	}
+	_, err = file.WriteString("dddddddd\n")
+	assert.Nil(t, err)
+
+	b, _ := os.ReadFile(path)
+	assert.Equal(t, "dddddddd\n", string(b))
+	b, _ = os.ReadFile(path + ".1")
+	assert.Equal(t, "cccccccc\n", string(b))
+	b, _ = os.ReadFile(path + ".2")
+	assert.Equal(t, "bbbbbbbb\n", string(b))
+	_, err = os.Stat(path + ".3")
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestListOutputFiles(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"order.100.output", "order.100.output.1", "order.batch.200.output",
+		"order.starting.1234.output", "orderx.300.output"} {
+		assert.Nil(t, os.WriteFile(filepath.Join(dir, name), []byte("x"), 0644))
+	}
+
+	assert.Equal(t, []string{filepath.Join(dir, "order.100.output")}, listOutputFiles(dir, "order", false))
+	assert.Equal(t, 2, len(listOutputFiles(dir, "order", true)))
+	assert.Equal(t, []string{filepath.Join(dir, "order.batch.200.output")}, listOutputFiles(dir, "order.batch", false))
+}
+
+func TestLinkOutputFile(t *testing.T) {
+	dir := t.TempDir()
+	temp := filepath.Join(dir, "sample.starting.1.output")
+	path := buildOutputFile(dir, "sample", 100)
+	assert.Nil(t, os.WriteFile(temp, []byte("juno"), 0644))
+	linkOutputFile("sample", temp, path)
+	b, _ := os.ReadFile(path)
+	assert.Equal(t, "juno", string(b))
+	_, err := os.Stat(temp)
+	assert.True(t, os.IsNotExist(err))
+
+	// fatima-core 프로세스가 먼저 만든 파일은 덮어쓰지 않는다
+	path = buildOutputFile(dir, "sample", 200)
+	assert.Nil(t, os.WriteFile(path, []byte("process"), 0644))
+	assert.Nil(t, os.WriteFile(temp, []byte("juno"), 0644))
+	linkOutputFile("sample", temp, path)
+	b, _ = os.ReadFile(path)
+	assert.Equal(t, "process", string(b))
+	_, err = os.Stat(temp)
+	assert.True(t, os.IsNotExist(err))
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/fatima-go/fatima-core"
 	"github.com/fatima-go/fatima-core/builder"
 	"github.com/fatima-go/juno/domain"
+	"github.com/fatima-go/juno/infra"
 )
 
 func (service *DomainService) GetPackageReport(loc *time.Location) domain.PackageReport {
@@ -63,12 +64,18 @@ func (service *DomainService) getPackageReportLinux(loc *time.Location) domain.P
 	report.ProcInfo = make([]domain.ProcessInfo, 0)
 	for _, v := range processList.processes {
 		report.ProcInfo = append(report.ProcInfo, *v)
-		if v.Status == domain.PROC_STATUS_ALIVE {
+		switch v.Status {
+		case domain.PROC_STATUS_ALIVE:
 			report.Summary.Alive = report.Summary.Alive + 1
-		} else {
+		case domain.PROC_STATUS_PAUSED:
+			report.Summary.Paused = report.Summary.Paused + 1
+		case domain.PROC_STATUS_HUNG:
+			report.Summary.Hung = report.Summary.Hung + 1
+		default:
 			report.Summary.Dead = report.Summary.Dead + 1
 		}
 	}
+	fillMaintenance(service.fatimaRuntime.GetEnv(), report.ProcInfo, loc)
 
 	return report
 }
@@ -79,12 +86,13 @@ func (service *DomainService) getPackageReportDarwin(loc *time.Location) domain.
 	processList := newProcessList(toGroupMap(yamlConfig.Groups))
 	yamlConfig.OrderByGroup()
 
+	table := ScanProcessTable()
 	for i, p := range yamlConfig.Processes {
 		processList.wg.Add(1)
 		index := i
 		item := p
 		go func() {
-			buildBasicProcessStatusDarwin(service.fatimaRuntime.GetEnv(), processList, index, item)
+			buildBasicProcessStatusDarwin(service.fatimaRuntime.GetEnv(), processList, index, item, table)
 		}()
 	}
 
@@ -104,12 +112,18 @@ func (service *DomainService) getPackageReportDarwin(loc *time.Location) domain.
 	report.ProcInfo = make([]domain.ProcessInfo, 0)
 	for _, v := range processList.processes {
 		report.ProcInfo = append(report.ProcInfo, *v)
-		if v.Status == domain.PROC_STATUS_ALIVE {
+		switch v.Status {
+		case domain.PROC_STATUS_ALIVE:
 			report.Summary.Alive = report.Summary.Alive + 1
-		} else {
+		case domain.PROC_STATUS_PAUSED:
+			report.Summary.Paused = report.Summary.Paused + 1
+		case domain.PROC_STATUS_HUNG:
+			report.Summary.Hung = report.Summary.Hung + 1
+		default:
 			report.Summary.Dead = report.Summary.Dead + 1
 		}
 	}
+	fillMaintenance(service.fatimaRuntime.GetEnv(), report.ProcInfo, loc)
 
 	return report
 }
@@ -127,50 +141,22 @@ func newProcessList(group map[int]string) *ProcessList {
 	return &pList
 }
 
-func buildBasicProcessStatus(env fatima.FatimaEnv, pList *ProcessList, item builder.ProcessItem) *domain.ProcessInfo {
+func buildBasicProcessStatus(env fatima.FatimaEnv, pList *ProcessList, item builder.ProcessItem, table *infra.ProcessTable) *domain.ProcessInfo {
 	proc := domain.NewProcessInfo()
 	proc.Name = item.Name
 	proc.Group = pList.group[item.Gid]
-	if len(item.Grep) == 0 {
-		pid := GetPid(env, item)
-		if pid != 0 {
-			if inspector.CheckProcessRunningByPid(proc.Name, pid) {
-				proc.Status = domain.PROC_STATUS_ALIVE
-				proc.Pid = strconv.Itoa(pid)
-			}
-		}
-	} else {
-		pid := GetPidByGrep(item.Grep)
-		if pid > 0 {
-			proc.Status = domain.PROC_STATUS_ALIVE
-			proc.Pid = strconv.Itoa(pid)
-		}
-	}
+	fillBasicProcessPid(env, proc, item, table)
 
 	return proc
 }
 
-func buildBasicProcessStatusDarwin(env fatima.FatimaEnv, pList *ProcessList, index int, item builder.ProcessItem) {
+func buildBasicProcessStatusDarwin(env fatima.FatimaEnv, pList *ProcessList, index int, item builder.ProcessItem, table *infra.ProcessTable) {
 	defer pList.wg.Done()
 	proc := domain.NewProcessInfo()
 	proc.Name = item.Name
 	proc.Index = index
 	proc.Group = pList.group[item.Gid]
-	if len(item.Grep) == 0 {
-		pid := GetPid(env, item)
-		if pid != 0 {
-			if inspector.CheckProcessRunningByPid(proc.Name, pid) {
-				proc.Status = domain.PROC_STATUS_ALIVE
-				proc.Pid = strconv.Itoa(pid)
-			}
-		}
-	} else {
-		pid := GetPidByGrep(item.Grep)
-		if pid > 0 {
-			proc.Status = domain.PROC_STATUS_ALIVE
-			proc.Pid = strconv.Itoa(pid)
-		}
-	}
+	fillBasicProcessPid(env, proc, item, table)
 
 	pList.processes = append(pList.processes, proc)
 }
@@ -192,3 +178,23 @@ func toGroupMap(groupItems []builder.GroupItem) map[int]string {
 
 	return groups
 }
+
+func fillBasicProcessPid(env fatima.FatimaEnv, proc *domain.ProcessInfo, item builder.ProcessItem, table *infra.ProcessTable) {
+	match := GetPidWithTable(env, item, table)
+	if match.Pid < 1 {
+		return
+	}
+
+	if len(item.Grep) == 0 && !inspector.CheckProcessRunningByPid(proc.Name, match.Pid) {
+		return
+	}
+
+	proc.Status = domain.PROC_STATUS_ALIVE
+	if entry, ok := table.Get(match.Pid); ok && entry.IsStopped() {
+		proc.Status = domain.PROC_STATUS_PAUSED
+	}
+	proc.Pid = strconv.Itoa(match.Pid)
+	if match.Ambiguous {
+		proc.Candidates = match.Candidates
+	}
+}
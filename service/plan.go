@@ -0,0 +1,248 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 5:41
+ */
+
+package service
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/fatima-go/fatima-core"
+	"github.com/fatima-go/fatima-core/builder"
+	"github.com/fatima-go/fatima-core/ipc"
+	"github.com/fatima-go/juno/domain"
+	"github.com/fatima-go/juno/infra"
+	"github.com/fatima-go/juno/service/goaway"
+)
+
+const (
+	// startProcessWithWeightGroup 의 checkProcessAliveWithDeadline deadline
+	planStartCheckDeadlineSec = 3
+	// stopProcessWithWeightGroup 에서 weight 그룹 사이에 대기하는 시간
+	planStopGroupIntervalSec = 1
+)
+
+// PlanOperation start/stop/restart 를 실제로 수행하지 않고 수행 계획을 만든다
+func (service *DomainService) PlanOperation(action string, all bool, group string, proc string) (domain.OperationPlan, error) {
+	plan := domain.OperationPlan{Action: action, Stages: make([]domain.PlanStage, 0), Skipped: make([]domain.PlanSkip, 0)}
+
+	switch action {
+	case domain.PlanActionStart, domain.PlanActionStop, domain.PlanActionRestart:
+	default:
+		return plan, fmt.Errorf("invalid action : %s", action)
+	}
+
+	env := service.fatimaRuntime.GetEnv()
+	yamlConfig := builder.NewYamlFatimaPackageConfig(env)
+	target := make([]fatima.FatimaPkgProc, 0)
+	if all {
+		target = yamlConfig.GetAllProc(true)
+	} else if len(group) > 0 {
+		if strings.ToLower(group) == "opm" {
+			return plan, fmt.Errorf("OPM group not permitted")
+		}
+		target = yamlConfig.GetProcByGroup(group)
+	} else if p := yamlConfig.GetProcByName(proc); p != nil {
+		target = append(target, p)
+	}
+	if len(target) == 0 {
+		return plan, fmt.Errorf("not found process")
+	}
+
+	groups := toGroupMap(yamlConfig.Groups)
+	table := ScanProcessTable()
+	alive := make(map[string]int)
+	candidates := make([]fatima.FatimaPkgProc, 0)
+	for _, p := range target {
+		if domain.IsManagedOpmProcess(p) {
+			plan.Skipped = append(plan.Skipped, domain.PlanSkip{Name: p.GetName(), Reason: "OPM process"})
+			continue
+		}
+		candidates = append(candidates, p)
+		if pid := findRunningPid(env, p, table); pid > 0 {
+			alive[p.GetName()] = pid
+		}
+	}
+
+	if action == domain.PlanActionStop || action == domain.PlanActionRestart {
+		stopTarget := make([]fatima.FatimaPkgProc, 0)
+		for _, p := range candidates {
+			comp := strings.ToLower(p.GetName())
+			if comp == "jupiter" || comp == "juno" {
+				plan.Skipped = append(plan.Skipped, domain.PlanSkip{Name: p.GetName(), Reason: "not permitted for killing"})
+			} else if _, ok := alive[p.GetName()]; !ok {
+				plan.Skipped = append(plan.Skipped, domain.PlanSkip{Name: p.GetName(), Reason: "not running"})
+			} else {
+				stopTarget = append(stopTarget, p)
+			}
+		}
+		plan.Stages = append(plan.Stages, buildStopStages(env, stopTarget, groups, alive)...)
+		if action == domain.PlanActionRestart && len(stopTarget) > 0 {
+			plan.Stages = append(plan.Stages, buildStopWaitStage(stopTarget, groups, alive))
+		}
+	}
+
+	if action == domain.PlanActionStart || action == domain.PlanActionRestart {
+		startTarget := make([]fatima.FatimaPkgProc, 0)
+		for _, p := range candidates {
+			if pid, ok := alive[p.GetName()]; ok && action == domain.PlanActionStart {
+				plan.Skipped = append(plan.Skipped, domain.PlanSkip{Name: p.GetName(), Reason: fmt.Sprintf("already running : %d", pid)})
+				continue
+			}
+			startTarget = append(startTarget, p)
+		}
+		plan.Stages = append(plan.Stages, buildStartStages(startTarget, groups)...)
+	}
+
+	for i := range plan.Stages {
+		plan.Stages[i].Order = i + 1
+		plan.ExpectedSec += plan.Stages[i].WaitSec
+	}
+	return plan, nil
+}
+
+func findRunningPid(env fatima.FatimaEnv, proc fatima.FatimaPkgProc, table *infra.ProcessTable) int {
+	match := GetPidWithTable(env, proc, table)
+	if match.Pid < 1 {
+		return 0
+	}
+	if len(proc.GetGrep()) == 0 && !inspector.CheckProcessRunningByPid(proc.GetName(), match.Pid) {
+		return 0
+	}
+	return match.Pid
+}
+
+func groupByWeight(list []fatima.FatimaPkgProc, desc bool) ([]int, map[int][]fatima.FatimaPkgProc) {
+	weightGroups := make(map[int][]fatima.FatimaPkgProc)
+	for _, p := range list {
+		weightGroups[p.GetWeight()] = append(weightGroups[p.GetWeight()], p)
+	}
+
+	weightList := make([]int, 0, len(weightGroups))
+	for weight := range weightGroups {
+		weightList = append(weightList, weight)
+	}
+	if desc {
+		sort.Sort(ByWeightDesc(weightList))
+	} else {
+		sort.Sort(ByWeightAsc(weightList))
+	}
+	return weightList, weightGroups
+}
+
+// buildStopStages stopProcessWithWeightGroup 과 동일하게 weight 오름차순으로 단계를 구성한다
+func buildStopStages(env fatima.FatimaEnv, list []fatima.FatimaPkgProc, groups map[int]string, alive map[string]int) []domain.PlanStage {
+	stages := make([]domain.PlanStage, 0)
+	weightList, weightGroups := groupByWeight(list, false)
+	for _, weight := range weightList {
+		stage := domain.PlanStage{Action: domain.PlanActionStop, Weight: weight, Processes: make([]domain.PlanProcess, 0)}
+		maxGoawaySec := 0
+		notes := make([]string, 0)
+		for _, p := range weightGroups[weight] {
+			methods := planGoaway(env, p)
+			for _, m := range methods {
+				switch m {
+				case domain.GoawayByIPC:
+					maxGoawaySec = max(maxGoawaySec, int(math.Ceil(goaway.MaxGoawayDuration().Seconds())))
+				case domain.GoawayByShell:
+					notes = append(notes, fmt.Sprintf("%s runs %s synchronously", p.GetName(), shellGoaway))
+				}
+			}
+			stage.Processes = append(stage.Processes, domain.PlanProcess{
+				Name:   p.GetName(),
+				Group:  groups[p.GetGid()],
+				Pid:    alive[p.GetName()],
+				Goaway: methods,
+			})
+		}
+		stage.WaitSec = maxGoawaySec + planStopGroupIntervalSec
+		if maxGoawaySec > 0 {
+			notes = append(notes, fmt.Sprintf("ipc goaway up to %ds", maxGoawaySec))
+		}
+		stage.WaitNote = strings.Join(notes, ", ")
+		stages = append(stages, stage)
+	}
+	return stages
+}
+
+// planGoaway executeGoaway 가 선택할 goaway 방법. IPC 가 불가능한 fatima 프로세스는 SIGUSR1 을 받고 goaway.sh 는 항상 수행된다
+func planGoaway(env fatima.FatimaEnv, proc fatima.FatimaPkgProc) []string {
+	methods := make([]string, 0)
+	if ipc.IsFatimaIPCAvailable(proc.GetName()) {
+		methods = append(methods, domain.GoawayByIPC)
+	} else if isFatimaOrientProcess(env, proc) {
+		methods = append(methods, domain.GoawayBySignal)
+	}
+
+	path := filepath.Join(env.GetFolderGuide().GetFatimaHome(), "app", proc.GetName(), shellGoaway)
+	if _, err := os.Stat(path); err == nil {
+		methods = append(methods, domain.GoawayByShell)
+	}
+
+	if len(methods) == 0 {
+		methods = append(methods, domain.GoawayByNothing)
+	}
+	return methods
+}
+
+// buildStopWaitStage restart 는 종료 후 대상 프로세스가 모두 내려갈 때까지 기다린 뒤 기동한다 (waitProcessesStopped)
+func buildStopWaitStage(list []fatima.FatimaPkgProc, groups map[int]string, alive map[string]int) domain.PlanStage {
+	stage := domain.PlanStage{Action: domain.PlanActionWait, Processes: make([]domain.PlanProcess, 0)}
+	for _, p := range list {
+		stage.Processes = append(stage.Processes, domain.PlanProcess{
+			Name:  p.GetName(),
+			Group: groups[p.GetGid()],
+			Pid:   alive[p.GetName()],
+		})
+	}
+	stage.WaitSec = int(restartStopDeadline.Seconds())
+	stage.WaitNote = fmt.Sprintf("wait until stopped up to %ds", stage.WaitSec)
+	return stage
+}
+
+// buildStartStages startProcessWithWeightGroup 과 동일하게 weight 내림차순으로 단계를 구성한다
+func buildStartStages(list []fatima.FatimaPkgProc, groups map[int]string) []domain.PlanStage {
+	stages := make([]domain.PlanStage, 0)
+	weightList, weightGroups := groupByWeight(list, true)
+	for _, weight := range weightList {
+		stage := domain.PlanStage{Action: domain.PlanActionStart, Weight: weight, Processes: make([]domain.PlanProcess, 0)}
+		maxStartSec := 0
+		for _, p := range weightGroups[weight] {
+			maxStartSec = max(maxStartSec, p.GetStartSec())
+			stage.Processes = append(stage.Processes, domain.PlanProcess{
+				Name:     p.GetName(),
+				Group:    groups[p.GetGid()],
+				StartSec: p.GetStartSec(),
+			})
+		}
+		if weight > 0 {
+			stage.WaitSec = max(1, maxStartSec) + planStartCheckDeadlineSec
+			stage.WaitNote = fmt.Sprintf("startsec %ds + alive check up to %ds", max(1, maxStartSec), planStartCheckDeadlineSec)
+		} else {
+			stage.WaitNote = "weight 0 group is not checked"
+		}
+		stages = append(stages, stage)
+	}
+	return stages
+}
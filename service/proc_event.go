@@ -0,0 +1,219 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 6:01
+ */
+
+package service
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/fatima-go/fatima-core"
+	"github.com/fatima-go/fatima-log"
+	"github.com/fatima-go/juno/domain"
+	"github.com/fatima-go/juno/infra"
+)
+
+const (
+	// 종료 이벤트 감지가 가능할 때 전체 프로세스 scan 주기 (fallback)
+	propMonitorPollSec = "monitor.poll.sec"
+
+	defaultMonitorPollSec = 10
+	// 종료 이벤트 감지가 불가능한 환경에서의 scan 주기
+	legacyMonitorPollSec = 1
+	// 기동/중지/재기동 직후에는 상태 변화를 빨리 반영하기 위해 매초 scan 한다
+	expediteScanDuration = 5 * time.Second
+)
+
+var monitorPollSec = defaultMonitorPollSec
+
+func loadMonitorConfig(config fatima.Config) {
+	if v, err := config.GetInt(propMonitorPollSec); err == nil && v > 0 {
+		monitorPollSec = v
+	}
+}
+
+// Run 프로세스 종료는 pidfd 이벤트로 즉시 감지하고 전체 scan 은 느린 주기로만 수행한다
+// pidfd 를 사용할 수 없다면 기존과 같이 매초 scan 한다
+func (p *processMonitor) Run() {
+	interval := time.Second * time.Duration(monitorPollSec)
+	watcher, err := infra.NewExitWatcher()
+	if err != nil {
+		log.Warn("event driven exit detection is not available. fallback to polling : %s", err.Error())
+		interval = time.Second * legacyMonitorPollSec
+	} else {
+		p.exitWatcher = watcher
+		go p.consumeExitEvents(watcher)
+	}
+	log.Info("process monitor scan interval : %s", interval)
+	configureMetricTiers(p.fatimaRuntime.GetEnv(), interval)
+
+	p.WatchProcesses()
+	lastScan := time.Now()
+	tick := time.NewTicker(time.Second)
+	for {
+		select {
+		case <-tick.C:
+			if time.Since(lastScan) < interval && !p.isExpedited() {
+				continue
+			}
+		case <-p.scanRequest:
+		}
+		p.WatchProcesses()
+		lastScan = time.Now()
+	}
+}
+
+func (p *processMonitor) consumeExitEvents(watcher *infra.ExitWatcher) {
+	for pid := range watcher.Events() {
+		p.onProcessExit(pid)
+	}
+}
+
+// requestScan 다음 scan 을 즉시 수행하도록 요청한다
+func (p *processMonitor) requestScan() {
+	select {
+	case p.scanRequest <- struct{}{}:
+	default:
+	}
+}
+
+// expedite 일정 시간 동안 매초 scan 하도록 한다
+func (p *processMonitor) expedite(d time.Duration) {
+	p.watchMutex.Lock()
+	defer p.watchMutex.Unlock()
+	until := time.Now().Add(d)
+	if until.After(p.expediteUntil) {
+		p.expediteUntil = until
+	}
+}
+
+func (p *processMonitor) isExpedited() bool {
+	p.watchMutex.Lock()
+	defer p.watchMutex.Unlock()
+	return time.Now().Before(p.expediteUntil)
+}
+
+// syncExitWatch scan 결과를 기준으로 감시 대상 pid 를 갱신한다
+func (p *processMonitor) syncExitWatch(processes []*domain.ProcessInfo) {
+	if p.exitWatcher == nil {
+		return
+	}
+
+	p.watchMutex.Lock()
+	defer p.watchMutex.Unlock()
+
+	alive := make(map[string]int)
+	for _, item := range processes {
+		if !item.IsRunning() {
+			continue
+		}
+		pid, err := strconv.Atoi(item.Pid)
+		if err != nil || pid < 1 {
+			continue
+		}
+		alive[item.Name] = pid
+	}
+
+	for name, pid := range p.watching {
+		if alive[name] != pid {
+			p.exitWatcher.Unwatch(pid)
+			delete(p.watching, name)
+		}
+	}
+
+	for name, pid := range alive {
+		if _, ok := p.watching[name]; ok {
+			continue
+		}
+		if err := p.exitWatcher.Watch(pid); err != nil {
+			log.Warn("[%s] fail to watch exit of pid %d : %s", name, pid, err.Error())
+			continue
+		}
+		p.watching[name] = pid
+	}
+}
+
+// onProcessExit pidfd 혹은 SIGCHLD 로 감지된 종료를 다음 scan 을 기다리지 않고 반영한다
+func (p *processMonitor) onProcessExit(pid int) {
+	p.watchMutex.Lock()
+	for name, watched := range p.watching {
+		if watched == pid {
+			delete(p.watching, name)
+			break
+		}
+	}
+	p.watchMutex.Unlock()
+
+	// DEAD 알람에 종료 정보를 첨부하기 위해 reaper 를 잠시 기다린다
+	_, _ = awaitChildExit(pid, childExitAwait)
+
+	p.monMutex.Lock()
+	previous, found := domain.ProcessInfo{}, false
+	for _, v := range p.procMap {
+		if v.IsRunning() && v.Pid == strconv.Itoa(pid) {
+			previous, found = v, true
+			break
+		}
+	}
+	if !found {
+		p.monMutex.Unlock()
+		return
+	}
+
+	log.Info("[%s] exit detected. pid=%d", previous.Name, pid)
+	now := time.Now().UnixMilli()
+	next := domain.NewProcessInfo()
+	next.Name = previous.Name
+	next.Group = previous.Group
+	next.Index = previous.Index
+	next.ICount = previous.ICount
+	next.Maintenance = previous.Maintenance
+	p.reflectRestartState(next, now)
+	next.LastExit = lastProcessExit(next.Name)
+	p.procMap[next.Name] = *next
+	p.exitEvents[next.Name] = now
+	p.notifyStatusChange(previous, *next)
+	p.reflectFlapState(next, now)
+	p.procMap[next.Name] = *next
+	p.monMutex.Unlock()
+
+	p.requestScan()
+}
+
+// isStaleScan scan 도중에 종료 이벤트가 반영되었다면 해당 scan 결과는 오래된 정보이다
+func (p *processMonitor) isStaleScan(proc string, scanStart int64) bool {
+	exitAt, ok := p.exitEvents[proc]
+	if !ok {
+		return false
+	}
+	if exitAt >= scanStart {
+		return true
+	}
+	delete(p.exitEvents, proc)
+	return false
+}
+
+// notifyProcessExit SIGCHLD reaper 에서 호출된다
+func notifyProcessExit(pid int) {
+	if procMonitor == nil {
+		return
+	}
+	go procMonitor.onProcessExit(pid)
+}
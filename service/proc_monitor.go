@@ -25,7 +25,6 @@ import (
 	"bytes"
 	"fmt"
 	"os"
-	"path/filepath"
 	"runtime"
 	"strconv"
 	"strings"
@@ -38,15 +37,18 @@ import (
 	"github.com/fatima-go/fatima-core/monitor"
 	"github.com/fatima-go/fatima-log"
 	"github.com/fatima-go/juno/domain"
+	"github.com/fatima-go/juno/infra"
 	"github.com/fatima-go/juno/web"
 )
 
 type ProcessMonitor interface {
+	Run()
 	WatchProcesses()
 	GetProcessList() []domain.ProcessInfo
 	GetProcess(name string, loc *time.Location) domain.ProcessInfo
 	ProcessStart(proc string)
 	ProcessStop(proc string)
+	ClearInternalJob(proc string)
 	ResetICount(proc string)
 }
 
@@ -58,7 +60,18 @@ type processMonitor struct {
 	jobMutex      sync.Mutex
 	procMap       map[string]domain.ProcessInfo
 	internalJobs  map[string]int
+	restarts      map[string]*restartRecord
 	yamlConfig    *builder.YamlFatimaPackageConfig
+	exitWatcher   *infra.ExitWatcher
+	watchMutex    sync.Mutex
+	watching      map[string]int
+	expediteUntil time.Time
+	scanRequest   chan struct{}
+	exitEvents    map[string]int64
+	thresholds    map[string]map[string]*thresholdState
+	flaps         map[string]*flapRecord
+	heartbeats    map[string]*heartbeatRecord
+	maintained    map[string]domain.ProcessInfo // 점검 모드 중에 중단된 프로세스의 중단 직전 상태
 }
 
 var procMonitor *processMonitor
@@ -70,6 +83,14 @@ func newProcessMonitor(fatimaRuntime fatima.FatimaRuntime) ProcessMonitor {
 	procMonitor.loc = time.UTC
 	procMonitor.procMap = make(map[string]domain.ProcessInfo)
 	procMonitor.internalJobs = make(map[string]int)
+	procMonitor.restarts = make(map[string]*restartRecord)
+	procMonitor.watching = make(map[string]int)
+	procMonitor.scanRequest = make(chan struct{}, 1)
+	procMonitor.exitEvents = make(map[string]int64)
+	procMonitor.thresholds = make(map[string]map[string]*thresholdState)
+	procMonitor.flaps = make(map[string]*flapRecord)
+	procMonitor.maintained = make(map[string]domain.ProcessInfo)
+	procMonitor.heartbeats = make(map[string]*heartbeatRecord)
 
 	for _, procName := range domain.GetManagedOpmProcessNames() {
 		deadline := lib.CurrentTimeMillis() + deadlineAfterStart
@@ -94,15 +115,18 @@ func (p *processMonitor) WatchProcesses() {
 	}()
 
 	// loc *time.Location
+	scanStart := time.Now().UnixMilli()
 	p.yamlConfig = builder.NewYamlFatimaPackageConfig(p.fatimaRuntime.GetEnv())
 
+	// 모든 프로세스가 한번의 process table scan 결과를 공유한다
+	table := ScanProcessTable()
 	mutex := &sync.Mutex{}
 	processList := newProcessList(toGroupMap(p.yamlConfig.Groups))
 	for _, proc := range p.yamlConfig.Processes {
 		processList.wg.Add(1)
 		item := proc
 		go func() {
-			builtProc := buildBasicProcessStatus(p.fatimaRuntime.GetEnv(), processList, item)
+			builtProc := buildBasicProcessStatus(p.fatimaRuntime.GetEnv(), processList, item, table)
 			mutex.Lock()
 			processList.processes = append(processList.processes, builtProc)
 			mutex.Unlock()
@@ -113,17 +137,33 @@ func (p *processMonitor) WatchProcesses() {
 	processList.wg.Wait()
 
 	inspector.MeasureProcessStatus(processList.processes, p.loc)
-	p.reflectProc(processList.processes)
+	recordProcessMetrics(p.fatimaRuntime.GetEnv(), processList.processes, time.Now())
+	rotateOutputFiles(p.fatimaRuntime.GetEnv(), processList.processes)
+	probes := p.probeHeartbeats(processList.processes)
+	p.reflectProc(processList.processes, scanStart, probes)
+	p.syncExitWatch(processList.processes)
 }
 
-func (p *processMonitor) reflectProc(processes []*domain.ProcessInfo) {
+func (p *processMonitor) reflectProc(processes []*domain.ProcessInfo, scanStart int64, probes map[string]heartbeatProbe) {
 	p.monMutex.Lock()
 	defer p.monMutex.Unlock()
+
+	// HUNG 판단은 상태 비교 전에 반영되어야 한다
+	now := time.Now().UnixMilli()
+	for _, item := range processes {
+		if !p.isStaleScan(item.Name, scanStart) {
+			p.reflectHeartbeat(item, now, probes)
+		}
+	}
+
 	for k, v := range p.procMap {
 		found := false
 		for _, item := range processes {
 			if k == item.Name {
 				found = true
+				if p.isStaleScan(item.Name, scanStart) {
+					break
+				}
 				if v.Status != item.Status {
 					p.notifyStatusChange(v, *item)
 				}
@@ -133,20 +173,62 @@ func (p *processMonitor) reflectProc(processes []*domain.ProcessInfo) {
 		if !found {
 			log.Info("reflect not found %s", k)
 			delete(p.procMap, k)
+			delete(p.restarts, k)
+			delete(p.thresholds, k)
+			delete(p.flaps, k)
+			delete(p.heartbeats, k)
+			delete(p.maintained, k)
 		}
 	}
 
 	for _, item := range processes {
-		prev, ok := p.procMap[item.Name]
-		if !ok {
-			p.procMap[item.Name] = *item
+		if p.isStaleScan(item.Name, scanStart) {
 			continue
 		}
-		item.ICount = prev.ICount
+		if prev, ok := p.procMap[item.Name]; ok {
+			item.ICount = prev.ICount
+		}
+		p.reflectRestartState(item, now)
+		item.LastExit = lastProcessExit(item.Name)
+		if !p.isInternalJob(item.Name) {
+			p.reflectThresholds(item, now)
+		}
+		p.reflectFlapState(item, now)
+		p.reflectMaintenanceExpiry(*item)
 		p.procMap[item.Name] = *item
 	}
 }
 
+// reflectRestartState 정상 동작 시간이 stable 기간을 넘으면 IC 와 재기동 이력을 초기화한다
+func (p *processMonitor) reflectRestartState(item *domain.ProcessInfo, now int64) {
+	policy, _ := readRestartPolicy(p.fatimaRuntime.GetEnv(), item.Name)
+	record := p.restartRecordOf(item.Name)
+	if item.IsRunning() {
+		if record.aliveSince == 0 {
+			record.aliveSince = now
+		}
+		dirty := item.GetICount() > 0 || len(record.history) > 0 || record.gaveUp
+		if dirty && now-record.aliveSince >= int64(policy.StableSec)*1000 {
+			log.Info("[%s] stable for %d sec. reset IC", item.Name, policy.StableSec)
+			item.ResetICount()
+			record.reset()
+		}
+	} else {
+		record.aliveSince = 0
+	}
+	record.prune(now, policy)
+	item.Restart = record.state(policy)
+}
+
+func (p *processMonitor) restartRecordOf(proc string) *restartRecord {
+	record, ok := p.restarts[proc]
+	if !ok {
+		record = &restartRecord{}
+		p.restarts[proc] = record
+	}
+	return record
+}
+
 const (
 	AlarmCategoryMonitor = "monitor"
 )
@@ -156,49 +238,97 @@ func (p *processMonitor) notifyStatusChange(previous, next domain.ProcessInfo) {
 		return // DARWIN not support at this time
 	}
 
+	// 기동 직후의 종료나 점검 모드 중의 종료도 crash 이력에 남긴다. 운영자가 요청한 중지만 제외한다
+	// 알람과 재기동만 아래에서 생략한다
+	if !next.IsRunning() && !p.isStopRequested(next.Name) {
+		p.recordCrash(previous)
+	}
+
 	if p.isInternalJob(next.Name) {
 		log.Info("[%s] is going internal", next.Name)
 		return
 	}
 
+	if m, ok := findMaintenance(p.fatimaRuntime.GetEnv(), next.Name, next.Group); ok {
+		log.Info("[%s] status changed %s to %s under maintenance(%s)", next.Name, previous.Status, next.Status, m.Key())
+		if next.IsRunning() {
+			delete(p.maintained, next.Name)
+		} else if _, ok := p.maintained[next.Name]; !ok {
+			p.maintained[next.Name] = previous
+		}
+		return
+	}
+
 	log.Warn("[%s] status changed %s to %s", next.Name, previous.Status, next.Status)
+	if p.observeFlap(next) {
+		p.sendStatusChangeAlarm(previous, next)
+	}
+
+	if !next.IsRunning() {
+		go p.restartProc(previous, next)
+	}
+}
+
+// reflectMaintenanceExpiry 점검 모드 중에 중단된 프로세스가 점검 모드 만료 후에도 기동되지 않았다면
+// 보류했던 알람을 보내고 재기동 정책을 적용한다
+func (p *processMonitor) reflectMaintenanceExpiry(item domain.ProcessInfo) {
+	previous, ok := p.maintained[item.Name]
+	if !ok {
+		return
+	}
+	if _, ok := findMaintenance(p.fatimaRuntime.GetEnv(), item.Name, item.Group); ok {
+		return
+	}
+
+	delete(p.maintained, item.Name)
+	if item.IsRunning() || p.isInternalJob(item.Name) {
+		return
+	}
+
+	log.Warn("[%s] maintenance expired but status is %s", item.Name, item.Status)
+	msg := fmt.Sprintf("점검 모드 만료 : [%s]의 상태가 %s입니다", item.Name, item.Status)
+	raiseAlarm(monitor.AlamLevelMajor, AlarmCategoryMonitor, domain.AlarmEventStatusChanged, item.Name, msg)
+	go p.restartProc(previous, item)
+}
+
+func (p *processMonitor) sendStatusChangeAlarm(previous, next domain.ProcessInfo) {
 	var alarmLvl monitor.AlarmLevel
 	alarmLvl = monitor.AlamLevelMajor
-	if next.Status == domain.PROC_STATUS_ALIVE {
+	switch next.Status {
+	case domain.PROC_STATUS_ALIVE:
 		alarmLvl = monitor.AlarmLevelMinor
+	case domain.PROC_STATUS_PAUSED:
+		alarmLvl = monitor.AlarmLevelWarn
 	}
 	msg := fmt.Sprintf("프로세스 상태 감지 : [%s]의 상태가 %s로 변경 되었습니다", next.Name, next.Status)
-	output := p.readMeaningfulOutputMessage(next.Name)
+	if next.Status == domain.PROC_STATUS_HUNG && next.Heartbeat != nil {
+		msg = fmt.Sprintf("%s\nheartbeat : %s\n조치 : %s", msg, next.Heartbeat.Text(), next.Heartbeat.Action)
+	}
+	if !next.IsRunning() {
+		if pid, err := strconv.Atoi(previous.Pid); err == nil {
+			msg = fmt.Sprintf("%s\n종료 정보 : %s", msg, resolveProcessExit(pid).Text())
+		}
+	}
+	output := p.readMeaningfulOutputMessage(previous)
 	if len(output) > 0 {
 		msg = fmt.Sprintf("%s\n```%s```", msg, output)
 	}
-	p.fatimaRuntime.GetSystemNotifyHandler().SendAlarmWithCategory(alarmLvl, monitor.ActionUnknown, msg, AlarmCategoryMonitor)
-
-	if next.Status != domain.PROC_STATUS_ALIVE {
-		go p.restartProc(next)
-	}
+	raiseAlarm(alarmLvl, AlarmCategoryMonitor, domain.AlarmEventStatusChanged, next.Name, msg)
 }
 
-func (p *processMonitor) readMeaningfulOutputMessage(proc string) string {
-	procDir := filepath.Join(p.fatimaRuntime.GetEnv().GetFolderGuide().GetFatimaHome(),
-		builder.FatimaFolderApp,
-		proc,
-		builder.FatimaFolderProc)
-	pidFile := filepath.Join(procDir, fmt.Sprintf("%s.pid", proc))
-	b, err := os.ReadFile(pidFile)
+// readMeaningfulOutputMessage 종료된 프로세스(previous)의 pid 로 output 파일을 찾는다
+// native 프로세스는 <proc>.pid 파일을 기록하지 않으므로 모니터가 알고 있던 pid 를 사용한다
+func (p *processMonitor) readMeaningfulOutputMessage(previous domain.ProcessInfo) string {
+	pid, err := strconv.Atoi(previous.Pid)
 	if err != nil {
-		log.Warn("fail to read pid file %s : %s", pidFile, err.Error())
 		return ""
 	}
 
-	pid, err := strconv.Atoi(strings.TrimSpace(string(b)))
-	if err != nil {
-		log.Warn("fail to convert pid number %s : %s", string(b), err.Error())
+	outputFile := findOutputFile(getProcDir(p.fatimaRuntime.GetEnv(), previous.Name), previous.Name, pid)
+	if len(outputFile) == 0 {
 		return ""
 	}
-
-	outputFile := filepath.Join(procDir, fmt.Sprintf("%s.%d.output", proc, pid))
-	b, err = os.ReadFile(outputFile)
+	b, err := os.ReadFile(outputFile)
 	if err != nil {
 		log.Warn("fail to read output file %s : %s", outputFile, err.Error())
 		return ""
@@ -263,39 +393,140 @@ func readFirstNLines(output []byte, lineCount int) string {
 	return buf.String()
 }
 
-func (p *processMonitor) restartProc(target domain.ProcessInfo) {
-	p.monMutex.Lock()
-	defer p.monMutex.Unlock()
-	procInfo, ok := p.procMap[target.Name]
-	if !ok {
+// restartProc 재기동 정책에 따라 backoff 후 프로세스를 재기동한다
+func (p *processMonitor) restartProc(previous, target domain.ProcessInfo) {
+	env := p.fatimaRuntime.GetEnv()
+	policy, _ := readRestartPolicy(env, target.Name)
+
+	if policy.Mode == domain.RestartNever {
+		log.Info("[%s] restart policy is never. skip restart", target.Name)
 		return
 	}
 
-	log.Info("%s IC = %d", target.Name, procInfo.GetICount())
-	if procInfo.GetICount() >= maxRestartCount {
-		log.Info("재시도 최대 회수 초과. 재시도 포기 : %s", target.Name)
-		time.Sleep(time.Second * 1)
-		msg := fmt.Sprintf("프로세스 재시도 최대 횟수 초과 : %s", target.Name)
-		p.fatimaRuntime.GetSystemNotifyHandler().SendAlarmWithCategory(monitor.AlamLevelMajor, monitor.ActionUnknown, msg, AlarmCategoryMonitor)
+	record, delay, ok := p.reserveRestart(target.Name, policy)
+	if !ok {
 		return
 	}
 
-	pkgProc := p.yamlConfig.GetProcByName(target.Name)
+	log.Info("[%s] restart after %s", target.Name, delay)
+	time.Sleep(delay)
+
+	p.monMutex.Lock()
+	record.nextRestart = 0
+	p.monMutex.Unlock()
+
+	// pidfd 로 감지한 경우 reaper 가 종료 코드를 기록하기 전일 수 있으므로 backoff 이후에 확인한다
+	if policy.Mode == domain.RestartOnFailure {
+		if pid, err := strconv.Atoi(previous.Pid); err == nil {
+			exit := resolveProcessExit(pid)
+			if exit.IsNormal() {
+				log.Info("[%s] exited normally. skip restart (on-failure)", target.Name)
+				return
+			}
+			if exit.Unknown {
+				log.Info("[%s] exit status of %d is unknown. treat as failure (on-failure)", target.Name, pid)
+			}
+		}
+	}
+
+	pkgProc := builder.NewYamlFatimaPackageConfig(env).GetProcByName(target.Name)
 	if pkgProc == nil {
 		log.Warn("not found pkg process %s", target.Name)
 		return
 	}
 
-	time.Sleep(time.Second * 3)
-	msg := fmt.Sprintf("프로세스 [%s] 를 재시작합니다", target.Name)
-	p.fatimaRuntime.GetSystemNotifyHandler().SendAlarmWithCategory(monitor.AlarmLevelWarn, monitor.ActionUnknown, msg, AlarmCategoryMonitor)
-	time.Sleep(time.Second * 1)
-	procInfo.AddICount()
-	p.procMap[target.Name] = procInfo
-	ExecuteProgram(p.fatimaRuntime.GetEnv(), pkgProc)
+	if _, ok := findMaintenance(env, target.Name, target.Group); ok {
+		log.Info("[%s] is under maintenance. skip restart", target.Name)
+		return
+	}
+
+	if findRunningPid(env, pkgProc, ScanProcessTable()) > 0 {
+		log.Info("[%s] is already running. skip restart", target.Name)
+		return
+	}
+
+	p.monMutex.Lock()
+	record.history = append(record.history, time.Now().UnixMilli())
+	if procInfo, ok := p.procMap[target.Name]; ok {
+		procInfo.AddICount()
+		p.procMap[target.Name] = procInfo
+	}
+	suppress := p.suppressRestartAlarm(target.Name)
+	p.monMutex.Unlock()
+
+	if !suppress {
+		msg := fmt.Sprintf("프로세스 [%s] 를 재시작합니다", target.Name)
+		raiseAlarm(monitor.AlarmLevelWarn, AlarmCategoryMonitor, domain.AlarmEventRestart, target.Name, msg)
+	}
+	pid, err := ExecuteProgram(env, pkgProc)
+	if err == nil {
+		RecordProcessEvent(env, domain.ProcessEvent{Process: target.Name, Event: domain.ProcessEventRestarted, Pid: pid,
+			Actor: domain.EventActorMonitor, Reason: fmt.Sprintf("auto restart (%s)", policy.Mode)})
+	}
+	p.expedite(expediteScanDuration)
+}
+
+// reserveRestart 재기동 정책(max_retry window, backoff)에 따라 재기동을 예약하고 backoff 지연 시간을 반환한다.
+// 이미 예약되어 있거나 최대 횟수를 초과했다면 false 를 반환한다
+func (p *processMonitor) reserveRestart(proc string, policy domain.RestartPolicy) (*restartRecord, time.Duration, bool) {
+	p.monMutex.Lock()
+	procInfo, ok := p.procMap[proc]
+	if !ok {
+		p.monMutex.Unlock()
+		return nil, 0, false
+	}
+	record := p.restartRecordOf(proc)
+	now := time.Now().UnixMilli()
+	record.prune(now, policy)
+	if record.nextRestart > now {
+		// 이미 재기동이 예약되어 있다
+		p.monMutex.Unlock()
+		return nil, 0, false
+	}
+
+	log.Info("%s IC = %d, restarts in window = %d", proc, procInfo.GetICount(), len(record.history))
+	if len(record.history) >= policy.MaxRetry {
+		alreadyGaveUp := record.gaveUp
+		record.gaveUp = true
+		p.monMutex.Unlock()
+		if alreadyGaveUp {
+			return nil, 0, false
+		}
+		log.Info("재시도 최대 회수 초과. 재시도 포기 : %s", proc)
+		msg := fmt.Sprintf("프로세스 재시도 최대 횟수 초과 : %s (%d회/%d초)", proc, policy.MaxRetry, policy.WindowSec)
+		raiseAlarm(monitor.AlamLevelMajor, AlarmCategoryMonitor, domain.AlarmEventRestartGaveUp, proc, msg)
+		return nil, 0, false
+	}
+
+	delay := policy.Backoff(len(record.history), restartRandom.Float64())
+	record.nextRestart = now + delay.Milliseconds()
+	p.monMutex.Unlock()
+	return record, delay, true
 }
 
-const maxRestartCount = 3
+// recordCrash 운영자 요청이 아닌 종료를 crashed 이벤트로 기록한다
+func (p *processMonitor) recordCrash(previous domain.ProcessInfo) {
+	event := domain.ProcessEvent{Process: previous.Name, Event: domain.ProcessEventCrashed, Actor: domain.EventActorMonitor,
+		Reason: fmt.Sprintf("status changed from %s", previous.Status)}
+	if pid, err := strconv.Atoi(previous.Pid); err == nil {
+		event.Pid = pid
+		exit := resolveProcessExit(pid)
+		event.Exit = &exit
+	}
+	RecordProcessEvent(p.fatimaRuntime.GetEnv(), event)
+}
+
+// findProcessByPid 실행중인 프로세스 중 pid 가 일치하는 프로세스 이름
+func (p *processMonitor) findProcessByPid(pid int) string {
+	p.monMutex.Lock()
+	defer p.monMutex.Unlock()
+	for _, v := range p.procMap {
+		if v.IsRunning() && v.Pid == strconv.Itoa(pid) {
+			return v.Name
+		}
+	}
+	return ""
+}
 
 func (p *processMonitor) GetProcessList() []domain.ProcessInfo {
 	list := make([]domain.ProcessInfo, 0)
@@ -314,7 +545,7 @@ func (p *processMonitor) GetProcess(name string, loc *time.Location) domain.Proc
 	defer p.monMutex.Unlock()
 	proc := p.procMap[name]
 
-	if proc.Status != domain.PROC_STATUS_ALIVE {
+	if !proc.IsRunning() {
 		return proc
 	}
 
@@ -336,6 +567,7 @@ func (p *processMonitor) ProcessStart(proc string) {
 
 	deadline := lib.CurrentTimeMillis() + deadlineAfterStart
 	p.internalJobs[proc] = deadline
+	p.expedite(expediteScanDuration)
 }
 
 const deadlineAfterStart = 3 * 1000
@@ -345,6 +577,23 @@ func (p *processMonitor) ProcessStop(proc string) {
 	p.jobMutex.Lock()
 	defer p.jobMutex.Unlock()
 	p.internalJobs[proc] = 0
+	p.expedite(expediteScanDuration)
+}
+
+// isStopRequested ProcessStop 으로 중지가 요청된 상태인지 여부
+func (p *processMonitor) isStopRequested(proc string) bool {
+	p.jobMutex.Lock()
+	defer p.jobMutex.Unlock()
+	deadline, ok := p.internalJobs[proc]
+	return ok && deadline == 0
+}
+
+// ClearInternalJob ProcessStop 으로 표시한 중지가 수행되지 않았을 때 모니터가 다시 상태 변경을 처리하도록 한다
+func (p *processMonitor) ClearInternalJob(proc string) {
+	log.Debug("Clear Internal Job : %s", proc)
+	p.jobMutex.Lock()
+	defer p.jobMutex.Unlock()
+	delete(p.internalJobs, proc)
 }
 
 func (p *processMonitor) isInternalJob(proc string) bool {
@@ -378,4 +627,7 @@ func (p *processMonitor) ResetICount(proc string) {
 
 	procInfo.ResetICount()
 	p.procMap[proc] = procInfo
+	if record, ok := p.restarts[proc]; ok {
+		record.reset()
+	}
 }
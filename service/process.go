@@ -22,7 +22,6 @@ package service
 
 import (
 	"bufio"
-	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -43,6 +42,7 @@ import (
 	"github.com/fatima-go/fatima-core/lib"
 	"github.com/fatima-go/fatima-log"
 	"github.com/fatima-go/juno/domain"
+	"github.com/fatima-go/juno/infra"
 	"github.com/fatima-go/juno/service/goaway"
 	"github.com/fatima-go/juno/web"
 )
@@ -77,50 +77,42 @@ func (service *DomainService) StartProcess(all bool, group string, proc string)
 	summary := make(map[string]string)
 	summary["package_name"] = service.fatimaRuntime.GetPackaging().GetName()
 
-	var buffer bytes.Buffer
-	output := startProcessWithWeightGroup(service.fatimaRuntime, target, processExecuteAsync)
-	buffer.WriteString(output)
-	buffer.WriteByte('\n')
-	summary["message"] = buffer.String()
+	results := startProcessWithWeightGroup(service.fatimaRuntime, target, processExecuteAsync)
+	recordProcessResults(service.fatimaRuntime.GetEnv(), results, service.eventActor(), "")
+	summary["message"] = results.Text() + "\n"
 	report["summary"] = summary
+	report["results"] = results
 	return report
 }
 
-func startProcess(env fatima.FatimaEnv, proc fatima.FatimaPkgProc) (int, string) {
+func startProcess(env fatima.FatimaEnv, proc fatima.FatimaPkgProc, table *infra.ProcessTable) domain.ProcessResult {
 	if proc == nil {
-		return 0, fmt.Sprintf("UNREGISTED PROCESS")
+		return domain.ProcessResult{Action: domain.ProcessActionStart, Outcome: domain.OutcomeUnregisted}
 	}
 
-	/*
-		START PROCESS : ifbccard\nFAIL TO EXECUTE\n
-		START PROCESS : fcmapp
-		SUCCESS : pid=65361
-		START PROCESS : fcmapp
-		ALEADY RUNNING 65361
-
-	*/
-
 	log.Warn("TRY TO START PROCESS : %s", proc.GetName())
 
-	var buffer bytes.Buffer
-	buffer.WriteString(fmt.Sprintf("\nSTART PROCESS : %s\n", proc.GetName()))
-
-	pid := GetPid(env, proc)
+	begin := time.Now()
+	result := domain.ProcessResult{Name: proc.GetName(), Action: domain.ProcessActionStart}
 
+	pid := GetPidWithTable(env, proc, table).Pid
 	if pid > 0 && inspector.CheckProcessRunningByPid(proc.GetName(), pid) {
-		buffer.WriteString(fmt.Sprintf("ALEADY RUNNING : %d", pid))
-		return 0, buffer.String()
+		result.Outcome = domain.OutcomeAlreadyRunning
+		result.OldPid = pid
+		return result
 	}
 
 	childPid, err := ExecuteProgram(env, proc)
+	result.NewPid = childPid
 	if err != nil {
-		buffer.WriteString(fmt.Sprintf("FAIL TO EXECUTE : %s", err.Error()))
+		result.Outcome = domain.OutcomeFail
+		result.Error = err.Error()
 	} else {
-		buffer.WriteString(fmt.Sprintf("SUCCESS : pid=%d", childPid))
+		result.Outcome = domain.OutcomeSuccess
 		GetProcessMonitor().ResetICount(proc.GetName())
 	}
-
-	return childPid, buffer.String()
+	result.DurationMs = time.Since(begin).Milliseconds()
+	return result
 }
 
 func (service *DomainService) StopProcess(all bool, group string, proc string) map[string]interface{} {
@@ -153,48 +145,52 @@ func (service *DomainService) StopProcess(all bool, group string, proc string) m
 	summary := make(map[string]string)
 	summary["package_name"] = service.fatimaRuntime.GetPackaging().GetName()
 
-	var buffer bytes.Buffer
-	output := stopProcessWithWeightGroup(service.fatimaRuntime, target, processTerminateAsync)
-	buffer.WriteString(output)
-	buffer.WriteByte('\n')
-	summary["message"] = buffer.String()
+	results := stopProcessWithWeightGroup(service.fatimaRuntime, target, processTerminateAsync)
+	recordProcessResults(service.fatimaRuntime.GetEnv(), results, service.eventActor(), "")
+	summary["message"] = results.Text() + "\n"
 	report["summary"] = summary
+	report["results"] = results
 	return report
 }
 
-func stopProcess(env fatima.FatimaEnv, proc fatima.FatimaPkgProc) (int, string) {
+func stopProcess(env fatima.FatimaEnv, proc fatima.FatimaPkgProc, table *infra.ProcessTable) domain.ProcessResult {
 	if proc == nil {
-		return 0, fmt.Sprintf("UNREGISTED PROCESS")
+		return domain.ProcessResult{Action: domain.ProcessActionStop, Outcome: domain.OutcomeUnregisted}
 	}
 
 	log.Warn("TRY TO STOP PROCESS : %s", proc.GetName())
 
-	var buffer bytes.Buffer
-	buffer.WriteString(fmt.Sprintf("\nSTOP PROCESS : %s\n", proc.GetName()))
+	begin := time.Now()
+	result := domain.ProcessResult{Name: proc.GetName(), Action: domain.ProcessActionStop}
 
 	comp := strings.ToLower(proc.GetName())
 	if comp == "jupiter" || comp == "juno" {
 		log.Warn("%s is not permitted for killing", proc.GetName())
-		buffer.WriteString(fmt.Sprintf("%s is not permitted for killing", proc.GetName()))
-		return 0, buffer.String()
+		result.Outcome = domain.OutcomeNotPermitted
+		return result
 	}
 
-	pid := GetPid(env, proc)
+	pid := GetPidWithTable(env, proc, table).Pid
 	if pid < 1 || !inspector.CheckProcessRunningByPid(proc.GetName(), pid) {
 		log.Info("%s[%d] is not running", proc.GetName(), pid)
-		buffer.WriteString("NOT RUNNING\n")
-		return 0, buffer.String()
+		result.Outcome = domain.OutcomeNotRunning
+		return result
 	}
 
+	result.OldPid = pid
 	executeGoaway(env, proc, pid)
-	err := KillProgram(proc.GetName(), pid)
+	tree, err := killProgramTree(proc.GetName(), pid)
 	if err != nil {
-		buffer.WriteString(fmt.Sprintf("FAIL TO KILL %s[%d] : %s", proc.GetName(), pid, err.Error()))
+		result.Outcome = domain.OutcomeFail
+		result.Error = err.Error()
 	} else {
-		buffer.WriteString(fmt.Sprintf("KILLED %d\n", pid))
+		result.Outcome = domain.OutcomeSuccess
+		if len(tree.Pids) > 1 {
+			result.Detail = formatProcessTree(tree)
+		}
 	}
-
-	return pid, buffer.String()
+	result.DurationMs = time.Since(begin).Milliseconds()
+	return result
 }
 
 // execute "goaway.sh"
@@ -254,106 +250,161 @@ const (
 	shellGoaway = "goaway.sh"
 )
 
-func (service *DomainService) RegistProcess(proc string, groupId string) error {
-	yamlConfig := builder.NewYamlFatimaPackageConfig(service.fatimaRuntime.GetEnv())
+// packageMutex fatima-package.yaml 의 프로세스 등록/해제 작업을 직렬화한다
+var packageMutex sync.Mutex
 
-	gid, err := strconv.Atoi(groupId)
+func (service *DomainService) RegistProcess(reg domain.ProcessRegistration) error {
+	log.Info("RegistProcess. reg=[%v]", reg)
+
+	err := reg.Validate()
 	if err != nil {
-		gid = yamlConfig.GetGroupId(groupId)
-		if gid < 0 {
-			return fmt.Errorf("invalid groupId format : %s", err.Error())
+		return err
+	}
+
+	packageMutex.Lock()
+	defer packageMutex.Unlock()
+
+	env := service.fatimaRuntime.GetEnv()
+	yamlConfig := builder.NewYamlFatimaPackageConfig(env)
+	if isExistProc(reg.Process, yamlConfig) {
+		return fmt.Errorf("aleady exist process %s", reg.Process)
+	}
+
+	p := builder.ProcessItem{}
+	p.Hb = false
+	logLevel := strings.ToLower(log.LogLevel(log.LOG_INFO).String())
+
+	if len(reg.Clone) > 0 {
+		source, ok := findProcessItem(reg.Clone, yamlConfig)
+		if !ok {
+			return fmt.Errorf("not found clone source process %s", reg.Clone)
+		}
+		p = source
+		if v, ok := service.readLogLevels()[source.Name]; ok {
+			if level, err := log.ConvertHexaToLogLevel(v); err == nil && level != log.LOG_NONE {
+				logLevel = strings.ToLower(level.String())
+			}
 		}
 	}
 
-	if isExistProc(proc, yamlConfig) {
-		return fmt.Errorf("aleady exist process %s", proc)
+	if len(reg.GroupId) > 0 {
+		gid, err := strconv.Atoi(reg.GroupId)
+		if err != nil {
+			gid = yamlConfig.GetGroupId(reg.GroupId)
+			if gid < 0 {
+				return fmt.Errorf("invalid groupId format : %s", err.Error())
+			}
+		}
+		p.Gid = gid
 	}
 
-	if !yamlConfig.IsValidGroupId(gid) {
+	if !yamlConfig.IsValidGroupId(p.Gid) {
 		return fmt.Errorf("invalid group id")
 	}
 
-	p := builder.ProcessItem{}
-	p.Gid = gid
-	p.Name = proc
-	p.Hb = false
-	var logLevel log.LogLevel
-	logLevel = log.LOG_INFO
-	p.Loglevel = strings.ToLower(logLevel.String())
+	p.Name = reg.Process
+	if len(reg.LogLevel) > 0 {
+		logLevel = strings.ToLower(reg.LogLevel)
+	}
+	p.Loglevel = logLevel
+	p, err = mergeRegistration(p, reg, yamlConfig.Processes)
+	if err != nil {
+		return err
+	}
 
-	yamlConfig.Processes = append(yamlConfig.Processes, p)
-	yamlConfig.Save()
+	// 실행 옵션도 함께 복제한다
+	if len(reg.Clone) > 0 {
+		if spec := readLaunchSpec(env, reg.Clone); !spec.IsEmpty() {
+			err = writeLaunchSpec(env, p.Name, spec)
+			if err != nil {
+				return fmt.Errorf("fail to clone launch spec : %s", err.Error())
+			}
+		}
+	}
 
 	// reflect loglevel
 	m := service.readLogLevels()
-	m[proc] = log.ConvertLogLevelToHexa(logLevel.String())
+	m[p.Name] = log.ConvertLogLevelToHexa(logLevel)
 	service.writeLogLevels(m)
 
+	yamlConfig.Processes = append(yamlConfig.Processes, p)
+	yamlConfig.Save()
+
 	return nil
 }
 
-func isExistProc(proc string, yamlConfig *builder.YamlFatimaPackageConfig) bool {
-	comp := strings.ToLower(proc)
+// mergeRegistration 등록 요청에 지정된 값으로 덮어쓴다 (clone 이라면 p 는 원본 프로세스의 속성)
+// 다른 프로세스와 같은 grep 은 기존 프로세스의 pid 를 찾게 되므로 허용하지 않는다
+func mergeRegistration(p builder.ProcessItem, reg domain.ProcessRegistration, processes []builder.ProcessItem) (builder.ProcessItem, error) {
+	if len(reg.Clone) > 0 {
+		if len(strings.TrimSpace(p.Grep)) > 0 && len(reg.Grep) == 0 {
+			return p, fmt.Errorf("grep is required to clone %s : grep of source matches source process", reg.Clone)
+		}
+		p.Grep = ""
+	}
+
+	if reg.Hb != nil {
+		p.Hb = *reg.Hb
+	}
+	if len(reg.Path) > 0 {
+		p.Path = reg.Path
+	}
+	if len(reg.Grep) > 0 {
+		p.Grep = reg.Grep
+	}
+	if reg.Startmode != nil {
+		p.Startmode = *reg.Startmode
+	}
+	if reg.Weight != nil {
+		p.Weight = *reg.Weight
+	}
+	if reg.StartSec != nil {
+		p.StartSec = *reg.StartSec
+	}
+
+	if err := checkDuplicatedGrep(reg.Process, p.Grep, processes); err != nil {
+		return p, err
+	}
+	return p, nil
+}
 
+// checkDuplicatedGrep 다른 프로세스가 같은 grep 을 사용하면 두 정의가 같은 pid 를 가리키게 된다
+func checkDuplicatedGrep(name string, grep string, processes []builder.ProcessItem) error {
+	grep = strings.TrimSpace(grep)
+	if len(grep) == 0 {
+		return nil
+	}
+	for _, e := range processes {
+		if strings.EqualFold(e.Name, name) {
+			continue
+		}
+		if strings.TrimSpace(e.Grep) == grep {
+			return fmt.Errorf("grep [%s] is already used by %s", grep, e.Name)
+		}
+	}
+	return nil
+}
+
+func findProcessItem(proc string, yamlConfig *builder.YamlFatimaPackageConfig) (builder.ProcessItem, bool) {
+	comp := strings.ToLower(proc)
 	for _, p := range yamlConfig.Processes {
 		if comp == strings.ToLower(p.GetName()) {
-			return true
+			return p, true
 		}
 	}
-
-	return false
+	return builder.ProcessItem{}, false
 }
 
-func (service *DomainService) UnregistProcess(proc string) error {
-	yamlConfig := builder.NewYamlFatimaPackageConfig(service.fatimaRuntime.GetEnv())
+func isExistProc(proc string, yamlConfig *builder.YamlFatimaPackageConfig) bool {
 	comp := strings.ToLower(proc)
-	found := -1
 
-	for i, p := range yamlConfig.Processes {
+	for _, p := range yamlConfig.Processes {
 		if comp == strings.ToLower(p.GetName()) {
-			found = i
-			break
+			return true
 		}
 	}
 
-	if found < 0 {
-		// not found
-		return fmt.Errorf("not found process %s", proc)
-	}
-
-	yamlConfig.Processes = append(yamlConfig.Processes[:found], yamlConfig.Processes[found+1:]...)
-	yamlConfig.Save()
-
-	// reflect loglevel
-	m := service.readLogLevels()
-	delete(m, proc)
-	service.writeLogLevels(m)
-
-	// unlink app
-	unlinkApp(service.fatimaRuntime.GetEnv(), proc)
-	fatimaDir := service.fatimaRuntime.GetEnv().GetFolderGuide().GetFatimaHome()
-
-	// remove all logs
-	appLogDir := filepath.Join(fatimaDir, builder.FatimaFolderLog, proc)
-	os.RemoveAll(appLogDir)
-	log.Debug("removed log dir : %s", appLogDir)
-
-	// remove app revisions
-	appRevDir := filepath.Join(fatimaDir, builder.FatimaFolderApp, domain.FOLDER_APP_REVISION, proc)
-	os.RemoveAll(appRevDir)
-	log.Debug("removed rev dir : %s", appRevDir)
-
-	// remove data
-	appDataDir := filepath.Join(fatimaDir, builder.FatimaFolderData, proc)
-	os.RemoveAll(appDataDir)
-	log.Debug("removed data dir : %s", appDataDir)
-
-	// remove deployment history dir
-	deploymentHistoryDir := buildHistorySaveDir(service.fatimaRuntime.GetEnv(), proc)
-	os.RemoveAll(deploymentHistoryDir)
-	log.Debug("removed deployment history dir : %s", deploymentHistoryDir)
-
-	return nil
+	return false
 }
 
 func (service *DomainService) ClearIcProcess(all bool, group string, proc string) map[string]interface{} {
@@ -386,18 +437,23 @@ func (service *DomainService) ClearIcProcess(all bool, group string, proc string
 	summary := make(map[string]string)
 	summary["package_name"] = service.fatimaRuntime.GetPackaging().GetName()
 
-	var buffer bytes.Buffer
+	mu := sync.Mutex{}
+	results := make(domain.ProcessResults, 0, size)
 	cyBarrier := lib.NewCyclicBarrier(size, nil)
 	for _, v := range target {
 		t := v
 		cyBarrier.Dispatch(func() {
-			buffer.WriteString(clearIcProcess(service.fatimaRuntime.GetEnv(), t))
+			result := clearIcProcess(service.fatimaRuntime.GetEnv(), t)
+			mu.Lock()
+			results = append(results, result)
+			mu.Unlock()
 		})
 	}
 	cyBarrier.Wait()
 
-	summary["message"] = buffer.String()
+	summary["message"] = results.Text()
 	report["summary"] = summary
+	report["results"] = results
 	return report
 }
 
@@ -471,17 +527,21 @@ func readFileAsMap(deploymentFilePath string) (map[string]interface{}, error) {
 	return deployment, nil
 }
 
-func clearIcProcess(env fatima.FatimaEnv, proc fatima.FatimaPkgProc) string {
+func clearIcProcess(env fatima.FatimaEnv, proc fatima.FatimaPkgProc) domain.ProcessResult {
 	if proc == nil {
-		return fmt.Sprintf("UNREGISTED PROCESS")
+		return domain.ProcessResult{Action: domain.ProcessActionClric, Outcome: domain.OutcomeUnregisted}
 	}
 
 	log.Warn("CLRIC PROCESS : %s", proc.GetName())
 
-	var buffer bytes.Buffer
-	buffer.WriteString(fmt.Sprintf("\nCLRIC PROCESS : %s\n", proc.GetName()))
+	begin := time.Now()
 	GetProcessMonitor().ResetICount(proc.GetName())
-	return buffer.String()
+	return domain.ProcessResult{
+		Name:       proc.GetName(),
+		Action:     domain.ProcessActionClric,
+		Outcome:    domain.OutcomeSuccess,
+		DurationMs: time.Since(begin).Milliseconds(),
+	}
 }
 
 func (service *DomainService) GetProcessReport(loc *time.Location, proc string) domain.ProcessReport {
@@ -495,12 +555,14 @@ func (service *DomainService) GetProcessReport(loc *time.Location, proc string)
 	ctx.report.Package.Host = service.fatimaRuntime.GetPackaging().GetHost()
 	ctx.report.Package.Name = service.fatimaRuntime.GetPackaging().GetName()
 
-	ctx.wg.Add(5)
+	ctx.wg.Add(7)
 	go loadProcessDescription(ctx)
 	go loadProcessStatus(ctx)
 	go loadBatchJobs(ctx, service.GetCronsDir())
 	go loadDeployment(ctx)
 	go loadMonitoringTail(ctx)
+	go loadLaunchInfo(ctx)
+	go loadEventTimeline(ctx)
 
 	ctx.wg.Wait()
 
@@ -604,7 +666,7 @@ func getProcessStatusInDarwin(ctx *ProcessReportContext) {
 		item := p
 		if p.Name == ctx.proc {
 			processList.wg.Add(1)
-			buildBasicProcessStatusDarwin(ctx.fatimaRuntime.GetEnv(), processList, index, item)
+			buildBasicProcessStatusDarwin(ctx.fatimaRuntime.GetEnv(), processList, index, item, ScanProcessTable())
 			break
 		}
 	}
@@ -716,6 +778,22 @@ func loadMonitoringTail(ctx *ProcessReportContext) {
 
 }
 
+func loadLaunchInfo(ctx *ProcessReportContext) {
+	defer ctx.wg.Done()
+
+	yamlConfig := builder.NewYamlFatimaPackageConfig(ctx.fatimaRuntime.GetEnv())
+	proc := yamlConfig.GetProcByName(ctx.proc)
+	if proc == nil {
+		return
+	}
+
+	pid := GetPid(ctx.fatimaRuntime.GetEnv(), proc)
+	if pid > 0 && !inspector.CheckProcessRunningByPid(proc.GetName(), pid) {
+		pid = 0
+	}
+	ctx.report.Launch = buildLaunchInfo(ctx.fatimaRuntime.GetEnv(), proc, pid)
+}
+
 func getLastLineWithSeek(filepath string, lineCount int) string {
 	if lineCount < 1 {
 		return ""
@@ -805,23 +883,26 @@ func StartDeadProcessesWithWeightGroup(fatimaRuntime fatima.FatimaRuntime) {
 
 		targetProcList = append(targetProcList, proc)
 	}
-	startProcessWithWeightGroup(fatimaRuntime, targetProcList, processExecuteSerial)
+	results := startProcessWithWeightGroup(fatimaRuntime, targetProcList, processExecuteSerial)
+	recordProcessResults(fatimaRuntime.GetEnv(), results, domain.EventActorJuno, "start dead processes")
 }
 
 func startProcessWithWeightGroup(fatimaRuntime fatima.FatimaRuntime,
 	targetProcList []fatima.FatimaPkgProc,
-	executeFunc ProcessActionFunc) string {
+	executeFunc ProcessActionFunc) domain.ProcessResults {
+	results := make(domain.ProcessResults, 0)
 	platformImpl := platform.OSPlatform{}
 	procList, err := platformImpl.GetProcesses()
 	if err != nil {
-		return ""
+		return results
 	}
 
 	weightGroups := make(map[int][]fatima.FatimaPkgProc)
+	table := ScanProcessTable()
 
 	// gather target process list as weight group
 	for _, p := range targetProcList {
-		pid := GetPid(fatimaRuntime.GetEnv(), p)
+		pid := GetPidWithTable(fatimaRuntime.GetEnv(), p, table).Pid
 		if pid > 0 {
 			if domain.ExistInProcessListWithPid(procList, pid) {
 				continue // skip alive process
@@ -843,12 +924,11 @@ func startProcessWithWeightGroup(fatimaRuntime fatima.FatimaRuntime,
 	sort.Sort(ByWeightDesc(weightList))
 
 	// launch process by weight group
-	var buffer bytes.Buffer
 	for _, weight := range weightList {
 		weightedProcList := weightGroups[weight]
 		log.Info("weight %d : [%s]", weight, extractProcessNameList(weightedProcList))
 		launchedProcList, output := executeFunc(fatimaRuntime.GetEnv(), weightedProcList)
-		buffer.WriteString(output)
+		results = append(results, output...)
 		if weight > 0 {
 			// we don't need checking weight 0 process group
 			err = checkProcessAliveWithDeadline(fatimaRuntime.GetEnv(), launchedProcList, time.Second*3)
@@ -857,58 +937,63 @@ func startProcessWithWeightGroup(fatimaRuntime fatima.FatimaRuntime,
 			}
 		}
 	}
-	return buffer.String()
+	return results
 }
 
-type ProcessActionFunc func(env fatima.FatimaEnv, procList []fatima.FatimaPkgProc) (ProcessBriefInfo, string)
+type ProcessActionFunc func(env fatima.FatimaEnv, procList []fatima.FatimaPkgProc) (ProcessBriefInfo, domain.ProcessResults)
 
-func processExecuteSerial(env fatima.FatimaEnv, procList []fatima.FatimaPkgProc) (ProcessBriefInfo, string) {
+func processExecuteSerial(env fatima.FatimaEnv, procList []fatima.FatimaPkgProc) (ProcessBriefInfo, domain.ProcessResults) {
 	launchedProcList := make([]ProcessNameAndPid, 0)
+	results := make(domain.ProcessResults, 0, len(procList))
 	for _, proc := range procList {
-		item := ProcessNameAndPid{ProcName: proc.GetName()}
-		var err error
-		item.Pid, err = ExecuteProgram(env, proc)
+		begin := time.Now()
+		result := domain.ProcessResult{Name: proc.GetName(), Action: domain.ProcessActionStart, Outcome: domain.OutcomeSuccess}
+		pid, err := ExecuteProgram(env, proc)
+		result.NewPid = pid
+		result.DurationMs = time.Since(begin).Milliseconds()
 		if err != nil {
+			result.Outcome = domain.OutcomeFail
+			result.Error = err.Error()
+			results = append(results, result)
 			continue
 		}
-		launchedProcList = append(launchedProcList, item)
+		results = append(results, result)
+		launchedProcList = append(launchedProcList, ProcessNameAndPid{ProcName: proc.GetName(), Pid: pid})
 	}
-	return launchedProcList, ""
+	return launchedProcList, results
 }
 
-func processExecuteAsync(env fatima.FatimaEnv, procList []fatima.FatimaPkgProc) (ProcessBriefInfo, string) {
+func processExecuteAsync(env fatima.FatimaEnv, procList []fatima.FatimaPkgProc) (ProcessBriefInfo, domain.ProcessResults) {
 	launchedProcList := make([]ProcessNameAndPid, 0)
+	results := make(domain.ProcessResults, 0, len(procList))
 
 	size := len(procList)
 	if size == 0 {
-		return launchedProcList, ""
+		return launchedProcList, results
 	}
 
 	mu := sync.Mutex{}
-	var buffer bytes.Buffer
+	table := ScanProcessTable()
 	cyBarrier := lib.NewCyclicBarrier(size, nil)
 	for _, v := range procList {
 		t := v
 		cyBarrier.Dispatch(func() {
-			pid, output := startProcess(env, t)
+			result := startProcess(env, t, table)
 			mu.Lock()
-			buffer.WriteString(output)
-			buffer.WriteByte('\n')
-			if pid > 0 {
-				item := ProcessNameAndPid{ProcName: t.GetName()}
-				item.Pid = pid
-				launchedProcList = append(launchedProcList, item)
+			results = append(results, result)
+			if result.NewPid > 0 {
+				launchedProcList = append(launchedProcList, ProcessNameAndPid{ProcName: t.GetName(), Pid: result.NewPid})
 			}
 			mu.Unlock()
 		})
 	}
 	cyBarrier.Wait()
-	return launchedProcList, buffer.String()
+	return launchedProcList, results
 }
 
 func stopProcessWithWeightGroup(fatimaRuntime fatima.FatimaRuntime,
 	targetProcList []fatima.FatimaPkgProc,
-	executeFunc ProcessActionFunc) string {
+	executeFunc ProcessActionFunc) domain.ProcessResults {
 	weightGroups := make(map[int][]fatima.FatimaPkgProc)
 
 	// gather target process list as weight group
@@ -929,47 +1014,46 @@ func stopProcessWithWeightGroup(fatimaRuntime fatima.FatimaRuntime,
 	sort.Sort(ByWeightAsc(weightList))
 
 	// handle process by weight group
-	var buffer bytes.Buffer
+	results := make(domain.ProcessResults, 0)
 	for _, weight := range weightList {
 		weightedProcList := weightGroups[weight]
 		log.Info("weight %d : [%s]", weight, extractProcessNameList(weightedProcList))
 		launchedProcList, output := executeFunc(fatimaRuntime.GetEnv(), weightedProcList)
-		buffer.WriteString(output)
+		results = append(results, output...)
 		if launchedProcList.IsAllDead() {
 			continue
 		}
 		time.Sleep(time.Second)
 	}
-	return buffer.String()
+	return results
 }
 
-func processTerminateAsync(env fatima.FatimaEnv, procList []fatima.FatimaPkgProc) (ProcessBriefInfo, string) {
+func processTerminateAsync(env fatima.FatimaEnv, procList []fatima.FatimaPkgProc) (ProcessBriefInfo, domain.ProcessResults) {
 	launchedProcList := make([]ProcessNameAndPid, 0)
+	results := make(domain.ProcessResults, 0, len(procList))
 
 	size := len(procList)
 	if size == 0 {
-		return launchedProcList, ""
+		return launchedProcList, results
 	}
 
 	mu := sync.Mutex{}
-	var buffer bytes.Buffer
+	table := ScanProcessTable()
 	cyBarrier := lib.NewCyclicBarrier(size, nil)
 	for _, v := range procList {
 		t := v
 		cyBarrier.Dispatch(func() {
-			pid, output := stopProcess(env, t)
+			result := stopProcess(env, t, table)
 			mu.Lock()
-			buffer.WriteString(output)
-			if pid > 0 {
-				item := ProcessNameAndPid{ProcName: t.GetName()}
-				item.Pid = pid
-				launchedProcList = append(launchedProcList, item)
+			results = append(results, result)
+			if result.OldPid > 0 {
+				launchedProcList = append(launchedProcList, ProcessNameAndPid{ProcName: t.GetName(), Pid: result.OldPid})
 			}
 			mu.Unlock()
 		})
 	}
 	cyBarrier.Wait()
-	return launchedProcList, buffer.String()
+	return launchedProcList, results
 }
 
 func extractProcessNameList(list []fatima.FatimaPkgProc) string {
@@ -0,0 +1,57 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 6:43
+ */
+
+package service
+
+import (
+	"testing"
+
+	"github.com/fatima-go/fatima-core/builder"
+	"github.com/fatima-go/juno/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeRegistrationClone(t *testing.T) {
+	native := builder.ProcessItem{Gid: 2, Name: "agent1", Path: "/opt/agent/bin/agent", Grep: "agent --id=1", Weight: 3}
+	java := builder.ProcessItem{Gid: 2, Name: "worker1", Weight: 1, StartSec: 5}
+	processes := []builder.ProcessItem{native, java}
+
+	// grep 을 지정하지 않으면 원본 프로세스와 매칭되므로 거절한다
+	_, err := mergeRegistration(native, domain.ProcessRegistration{Process: "agent2", Clone: "agent1"}, processes)
+	assert.NotNil(t, err)
+
+	// 원본과 같은 grep 도 거절한다
+	_, err = mergeRegistration(native, domain.ProcessRegistration{Process: "agent2", Clone: "agent1", Grep: "agent --id=1"}, processes)
+	assert.NotNil(t, err)
+
+	p, err := mergeRegistration(native, domain.ProcessRegistration{Process: "agent2", Clone: "agent1", Grep: "agent --id=2"}, processes)
+	assert.Nil(t, err)
+	assert.Equal(t, "/opt/agent/bin/agent", p.Path)
+	assert.Equal(t, "agent --id=2", p.Grep)
+	assert.Equal(t, 3, p.Weight)
+
+	// grep 이 없는 원본은 나머지 속성만 복제하고 지정된 값으로 덮어쓴다
+	weight := 2
+	p, err = mergeRegistration(java, domain.ProcessRegistration{Process: "worker2", Clone: "worker1", Weight: &weight}, processes)
+	assert.Nil(t, err)
+	assert.Empty(t, p.Grep)
+	assert.Equal(t, 2, p.Weight)
+	assert.Equal(t, 5, p.StartSec)
+}
@@ -0,0 +1,182 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 5:36
+ */
+
+package service
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"sort"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/fatima-go/fatima-core/monitor"
+	"github.com/fatima-go/fatima-log"
+	"github.com/fatima-go/juno/domain"
+	"github.com/fatima-go/juno/infra"
+)
+
+const (
+	// 종료 요청 후 잔존 프로세스를 검사하기까지의 유예 시간
+	leftoverCheckDelay = 10 * time.Second
+)
+
+// ProcessTree 종료 대상이 되는 프로세스 트리 정보
+type ProcessTree struct {
+	Pid  int
+	Pgid int   // process group 전체를 종료할 수 있을 때만 0 보다 크다
+	Pids []int // pid, group member, 자손을 모두 포함
+}
+
+var (
+	launchGroupMutex sync.Mutex
+	// launchGroups 프로세스 이름 -> ExecuteProgram 시점에 만든 process group id (기동 pid)
+	launchGroups = make(map[string]int)
+)
+
+// recordLaunchGroup 기동한 pid 가 leader 인 process group 을 기억한다
+// leader(java 기동 shell 등)가 먼저 종료되더라도 group 전체를 종료할 수 있도록 한다
+func recordLaunchGroup(proc string, pgid int) {
+	launchGroupMutex.Lock()
+	defer launchGroupMutex.Unlock()
+	launchGroups[proc] = pgid
+}
+
+func lookupLaunchGroup(proc string) int {
+	launchGroupMutex.Lock()
+	defer launchGroupMutex.Unlock()
+	return launchGroups[proc]
+}
+
+// setProcessGroup 기동되는 프로세스를 별도의 process group 의 leader 로 만든다
+func setProcessGroup(cmd *exec.Cmd) {
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	cmd.SysProcAttr.Setpgid = true
+	cmd.SysProcAttr.Pgid = 0
+}
+
+// collectProcessTree pid 가 속한 process group 과 자손 프로세스를 수집한다
+// 기동 시 recordLaunchGroup 으로 기록한 group 일 경우에만 group 을 종료 대상으로 삼는다
+func collectProcessTree(proc string, pid int, table *infra.ProcessTable) ProcessTree {
+	tree := ProcessTree{Pid: pid}
+
+	pgid, err := syscall.Getpgid(pid)
+	if err == nil && pgid > 1 && pgid != syscall.Getpgrp() && pgid == lookupLaunchGroup(proc) {
+		tree.Pgid = pgid
+	}
+
+	seen := map[int]bool{pid: true}
+	tree.Pids = []int{pid}
+	add := func(list []int) {
+		for _, v := range list {
+			if !seen[v] {
+				seen[v] = true
+				tree.Pids = append(tree.Pids, v)
+			}
+		}
+	}
+	if tree.Pgid > 0 {
+		for _, member := range table.GroupMembers(tree.Pgid) {
+			add([]int{member})
+			add(table.Descendants(member))
+		}
+	}
+	add(table.Descendants(pid))
+	sort.Ints(tree.Pids)
+	return tree
+}
+
+// signalProcessTree group 전체와 group 밖으로 벗어난 자손들에게 signal 을 전송한다
+func signalProcessTree(tree ProcessTree, sig syscall.Signal) error {
+	var err error
+	if tree.Pgid > 0 {
+		err = syscall.Kill(-tree.Pgid, sig)
+	} else {
+		err = syscall.Kill(tree.Pid, sig)
+	}
+
+	for _, pid := range tree.Pids {
+		if pid == tree.Pid {
+			continue
+		}
+		if tree.Pgid > 0 {
+			if pgid, e := syscall.Getpgid(pid); e == nil && pgid == tree.Pgid {
+				continue
+			}
+		}
+		if e := syscall.Kill(pid, sig); e != nil && e != syscall.ESRCH {
+			log.Warn("fail to send %s to descendant %d : %s", sig, pid, e.Error())
+		}
+	}
+	return err
+}
+
+// findAliveProcesses tree 의 pid 중 아직 살아있는 pid 목록
+func findAliveProcesses(tree ProcessTree) []int {
+	alive := make([]int, 0)
+	for _, pid := range tree.Pids {
+		if syscall.Kill(pid, 0) == nil && !isZombieProcess(pid) {
+			alive = append(alive, pid)
+		}
+	}
+	return alive
+}
+
+func isZombieProcess(pid int) bool {
+	b, err := os.ReadFile(fmt.Sprintf("/proc/%d/stat", pid))
+	if err != nil {
+		return false
+	}
+	s := string(b)
+	for i := len(s) - 1; i >= 0; i-- {
+		if s[i] == ')' {
+			return i+2 < len(s) && s[i+2] == 'Z'
+		}
+	}
+	return false
+}
+
+// watchLeftoverProcesses 유예 시간 이후에도 남아있는 프로세스가 있다면 알린다
+func watchLeftoverProcesses(proc string, tree ProcessTree) {
+	if len(tree.Pids) < 2 && tree.Pgid == 0 {
+		return
+	}
+
+	time.Sleep(leftoverCheckDelay)
+	leftover := findAliveProcesses(tree)
+	if len(leftover) == 0 {
+		return
+	}
+
+	log.Warn("[%s] leftover processes after stop : %v", proc, leftover)
+	msg := fmt.Sprintf("프로세스 종료 후 잔존 프로세스 감지 : [%s] pid=%v", proc, leftover)
+	raiseAlarm(monitor.AlarmLevelWarn, AlarmCategoryMonitor, domain.AlarmEventLeftoverProcess, proc, msg)
+}
+
+func formatProcessTree(tree ProcessTree) string {
+	if tree.Pgid > 0 {
+		return fmt.Sprintf("pgid=%d, pids=%v", tree.Pgid, tree.Pids)
+	}
+	return fmt.Sprintf("pids=%v", tree.Pids)
+}
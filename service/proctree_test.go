@@ -0,0 +1,88 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 6:39
+ */
+
+package service
+
+import (
+	"os/exec"
+	"strconv"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/fatima-go/juno/infra"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCollectProcessTreeWithLaunchGroup(t *testing.T) {
+	// leader(shell) 는 바로 종료되고 group 에 자식만 남는다
+	cmd := exec.Command("sh", "-c", "sleep 30 >/dev/null 2>&1 & echo $!")
+	setProcessGroup(cmd)
+	out, err := cmd.Output()
+	if !assert.NoError(t, err) {
+		return
+	}
+	leader := cmd.Process.Pid
+	child, err := strconv.Atoi(strings.TrimSpace(string(out)))
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer syscall.Kill(-leader, syscall.SIGKILL)
+
+	table, err := infra.ScanProcessTable()
+	if !assert.NoError(t, err) {
+		return
+	}
+	tree := collectProcessTree("test.launch.group", child, table)
+	assert.Equal(t, 0, tree.Pgid)
+
+	recordLaunchGroup("test.launch.group", leader)
+	tree = collectProcessTree("test.launch.group", child, table)
+	assert.Equal(t, leader, tree.Pgid)
+
+	assert.NoError(t, signalProcessTree(tree, syscall.SIGKILL))
+	deadline := time.Now().Add(2 * time.Second)
+	for len(findAliveProcesses(tree)) > 0 && time.Now().Before(deadline) {
+		time.Sleep(20 * time.Millisecond)
+	}
+	assert.Empty(t, findAliveProcesses(tree))
+}
+
+func TestCollectProcessTreeUnrecordedGroup(t *testing.T) {
+	// 스스로 group leader 이더라도 기록되지 않은 group 은 종료 대상이 아니다
+	cmd := exec.Command("sleep", "30")
+	setProcessGroup(cmd)
+	if !assert.NoError(t, cmd.Start()) {
+		return
+	}
+	defer func() {
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+	}()
+
+	table, err := infra.ScanProcessTable()
+	if !assert.NoError(t, err) {
+		return
+	}
+	tree := collectProcessTree("test.unrecorded.group", cmd.Process.Pid, table)
+	assert.Equal(t, 0, tree.Pgid)
+	assert.Equal(t, []int{cmd.Process.Pid}, tree.Pids)
+}
@@ -0,0 +1,230 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 5:59
+ */
+
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/fatima-go/fatima-core"
+	"github.com/fatima-go/fatima-core/builder"
+	"github.com/fatima-go/fatima-log"
+	"github.com/fatima-go/juno/domain"
+)
+
+const (
+	restartPolicyDataDir = "restart"
+	// 기본 재기동 정책. 프로세스별 정책은 data 폴더의 restart/<proc>.json 에 저장한다
+	propRestartMode          = "restart.mode"
+	propRestartMaxRetry      = "restart.max.retry"
+	propRestartWindowSec     = "restart.window.sec"
+	propRestartBackoffInit   = "restart.backoff.initial.ms"
+	propRestartBackoffMax    = "restart.backoff.max.ms"
+	propRestartBackoffJitter = "restart.backoff.jitter.percent"
+	propRestartStableSec     = "restart.stable.sec"
+)
+
+var (
+	restartPolicyMutex sync.Mutex
+	restartPolicyCache = make(map[string]*domain.RestartPolicy)
+)
+
+var defaultRestartPolicy = domain.RestartPolicy{
+	Mode:             domain.RestartAlways,
+	MaxRetry:         3,
+	WindowSec:        600,
+	BackoffInitialMs: 3000,
+	BackoffMaxMs:     60000,
+	BackoffJitter:    0.2,
+	StableSec:        300,
+}
+
+func loadRestartConfig(config fatima.Config) {
+	policy := defaultRestartPolicy
+	if v, ok := config.GetValue(propRestartMode); ok {
+		policy.Mode = v
+	}
+	if v, err := config.GetInt(propRestartMaxRetry); err == nil {
+		policy.MaxRetry = v
+	}
+	if v, err := config.GetInt(propRestartWindowSec); err == nil {
+		policy.WindowSec = v
+	}
+	if v, err := config.GetInt(propRestartBackoffInit); err == nil {
+		policy.BackoffInitialMs = v
+	}
+	if v, err := config.GetInt(propRestartBackoffMax); err == nil {
+		policy.BackoffMaxMs = v
+	}
+	if v, err := config.GetInt(propRestartBackoffJitter); err == nil {
+		policy.BackoffJitter = float64(v) / 100
+	}
+	if v, err := config.GetInt(propRestartStableSec); err == nil {
+		policy.StableSec = v
+	}
+
+	if err := policy.Validate(); err != nil {
+		log.Warn("invalid default restart policy. use builtin default : %s", err.Error())
+		return
+	}
+	defaultRestartPolicy = policy
+}
+
+func buildRestartPolicyFile(env fatima.FatimaEnv, proc string) string {
+	return filepath.Join(env.GetFolderGuide().GetDataFolder(), restartPolicyDataDir, proc+".json")
+}
+
+// readRestartPolicy 프로세스별 정책이 없으면 기본 정책을 사용한다
+// 모니터가 매초 참조하므로 읽은 결과는 변경(UpdateRestartPolicy)전까지 캐시한다
+func readRestartPolicy(env fatima.FatimaEnv, proc string) (domain.RestartPolicy, bool) {
+	restartPolicyMutex.Lock()
+	defer restartPolicyMutex.Unlock()
+	if cached, ok := restartPolicyCache[proc]; ok {
+		if cached == nil {
+			return defaultRestartPolicy, false
+		}
+		return *cached, true
+	}
+
+	policy, err := loadRestartPolicyFile(buildRestartPolicyFile(env, proc))
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Warn("%s invalid restart policy : %s", proc, err.Error())
+		}
+		restartPolicyCache[proc] = nil
+		return defaultRestartPolicy, false
+	}
+	restartPolicyCache[proc] = &policy
+	return policy, true
+}
+
+func loadRestartPolicyFile(file string) (domain.RestartPolicy, error) {
+	policy := domain.RestartPolicy{}
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return policy, err
+	}
+	err = json.Unmarshal(b, &policy)
+	if err != nil {
+		return policy, err
+	}
+	return policy, policy.Validate()
+}
+
+func invalidateRestartPolicy(proc string) {
+	restartPolicyMutex.Lock()
+	defer restartPolicyMutex.Unlock()
+	delete(restartPolicyCache, proc)
+}
+
+func removeRestartPolicy(env fatima.FatimaEnv, proc string) {
+	_ = os.Remove(buildRestartPolicyFile(env, proc))
+	invalidateRestartPolicy(proc)
+}
+
+func (service *DomainService) GetRestartPolicy(proc string) (domain.RestartPolicy, bool, error) {
+	env := service.fatimaRuntime.GetEnv()
+	if builder.NewYamlFatimaPackageConfig(env).GetProcByName(proc) == nil {
+		return domain.RestartPolicy{}, false, fmt.Errorf("not found process %s", proc)
+	}
+	policy, custom := readRestartPolicy(env, proc)
+	return policy, custom, nil
+}
+
+// UpdateRestartPolicy policy 가 nil 이면 프로세스별 정책을 삭제하고 기본 정책을 따른다
+func (service *DomainService) UpdateRestartPolicy(proc string, policy *domain.RestartPolicy) error {
+	log.Info("UpdateRestartPolicy. proc=[%s], policy=[%v]", proc, policy)
+
+	env := service.fatimaRuntime.GetEnv()
+	if builder.NewYamlFatimaPackageConfig(env).GetProcByName(proc) == nil {
+		return fmt.Errorf("not found process %s", proc)
+	}
+
+	file := buildRestartPolicyFile(env, proc)
+	defer invalidateRestartPolicy(proc)
+	if policy == nil {
+		err := os.Remove(file)
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+
+	err := policy.Validate()
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(file), 0755)
+	if err != nil {
+		return fmt.Errorf("fail to make dir %s : %s", filepath.Dir(file), err.Error())
+	}
+	b, err := json.MarshalIndent(policy, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(file, b, 0644)
+}
+
+// restartRecord 프로세스별 자동 재기동 이력
+type restartRecord struct {
+	history     []int64 // window 안의 재기동 시각 (unix millis)
+	nextRestart int64
+	gaveUp      bool
+	aliveSince  int64 // 마지막으로 ALIVE 가 된 시각. stable 판단에 사용
+}
+
+// prune window 를 벗어난 이력을 제거한다
+func (r *restartRecord) prune(now int64, policy domain.RestartPolicy) {
+	from := now - int64(policy.WindowSec)*1000
+	i := 0
+	for i < len(r.history) && r.history[i] < from {
+		i++
+	}
+	r.history = r.history[i:]
+}
+
+func (r *restartRecord) reset() {
+	r.history = nil
+	r.nextRestart = 0
+	r.gaveUp = false
+}
+
+func (r *restartRecord) state(policy domain.RestartPolicy) *domain.RestartState {
+	state := &domain.RestartState{
+		Policy:      policy.Mode,
+		Attempts:    len(r.history),
+		MaxRetry:    policy.MaxRetry,
+		NextRestart: r.nextRestart,
+		GaveUp:      r.gaveUp,
+	}
+	if len(r.history) > 0 {
+		state.LastRestart = r.history[len(r.history)-1]
+	}
+	return state
+}
+
+var restartRandom = rand.New(rand.NewSource(time.Now().UnixNano()))
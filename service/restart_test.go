@@ -0,0 +1,81 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 5:59
+ */
+
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fatima-go/juno/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRestartBackoff(t *testing.T) {
+	policy := defaultRestartPolicy
+	policy.BackoffInitialMs = 1000
+	policy.BackoffMaxMs = 10000
+	policy.BackoffJitter = 0
+
+	assert.Equal(t, time.Second, policy.Backoff(0, 0.5))
+	assert.Equal(t, 2*time.Second, policy.Backoff(1, 0.5))
+	assert.Equal(t, 8*time.Second, policy.Backoff(3, 0.5))
+	assert.Equal(t, 10*time.Second, policy.Backoff(10, 0.5))
+
+	policy.BackoffJitter = 0.5
+	assert.Equal(t, 500*time.Millisecond, policy.Backoff(0, 0))
+	assert.Equal(t, 1500*time.Millisecond, policy.Backoff(0, 1))
+	assert.Equal(t, 10*time.Second, policy.Backoff(10, 1))
+}
+
+func TestRestartRecordWindow(t *testing.T) {
+	policy := domain.RestartPolicy{Mode: domain.RestartAlways, MaxRetry: 3, WindowSec: 60}
+	record := &restartRecord{history: []int64{1000, 50000, 90000}}
+
+	record.prune(100000, policy)
+	assert.Equal(t, []int64{50000, 90000}, record.history)
+
+	state := record.state(policy)
+	assert.Equal(t, 2, state.Attempts)
+	assert.Equal(t, int64(90000), state.LastRestart)
+
+	record.reset()
+	assert.Equal(t, 0, len(record.history))
+}
+
+func TestReserveRestart(t *testing.T) {
+	p := &processMonitor{
+		procMap:  map[string]domain.ProcessInfo{"sample": {Name: "sample"}},
+		restarts: make(map[string]*restartRecord),
+	}
+	policy := domain.RestartPolicy{Mode: domain.RestartAlways, MaxRetry: 3, WindowSec: 60, BackoffInitialMs: 1000, BackoffMaxMs: 1000}
+
+	record, delay, ok := p.reserveRestart("sample", policy)
+	assert.True(t, ok)
+	assert.Equal(t, time.Second, delay)
+
+	// 예약된 재기동이 있으면 조치에 의한 재기동도 겹치지 않는다
+	_, _, ok = p.reserveRestart("sample", policy)
+	assert.False(t, ok)
+
+	record.nextRestart = 0
+	_, _, ok = p.reserveRestart("unknown", policy)
+	assert.False(t, ok)
+}
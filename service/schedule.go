@@ -0,0 +1,376 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 5:40
+ */
+
+package service
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fatima-go/fatima-core"
+	"github.com/fatima-go/fatima-core/builder"
+	"github.com/fatima-go/fatima-log"
+	"github.com/fatima-go/juno/domain"
+	"github.com/fatima-go/juno/web"
+)
+
+const (
+	scheduleDataDir    = "schedule"
+	scheduleAuditFile  = "audit.log"
+	scheduleAuditLimit = 50
+	// audit 파일은 최근 keep 개만 보관하며 slack 만큼 넘으면 정리한다
+	scheduleAuditKeepCount    = 1000
+	scheduleAuditCompactSlack = 100
+	// juno 가 중지되어 있던 등의 이유로 실행 시각을 놓쳤을 때 허용하는 지연 시간
+	scheduleMisfireGrace = 10 * time.Minute
+	scheduleMaxAhead     = 90 * 24 * time.Hour
+	// restart 시 프로세스 종료를 기다리는 최대 시간
+	restartStopDeadline = 30 * time.Second
+)
+
+var (
+	scheduleMutex sync.Mutex
+	// runningOperations 이 juno 에서 실행중인 예약 작업 id
+	runningOperations = make(map[string]bool)
+)
+
+func buildScheduleDir(env fatima.FatimaEnv) string {
+	return filepath.Join(env.GetFolderGuide().GetDataFolder(), scheduleDataDir)
+}
+
+func readScheduledOperations(env fatima.FatimaEnv) []domain.ScheduledOperation {
+	list := make([]domain.ScheduledOperation, 0)
+	files, err := filepath.Glob(filepath.Join(buildScheduleDir(env), "*.json"))
+	if err != nil {
+		return list
+	}
+
+	for _, f := range files {
+		b, err := os.ReadFile(f)
+		if err != nil {
+			continue
+		}
+		op := domain.ScheduledOperation{}
+		err = json.Unmarshal(b, &op)
+		if err != nil {
+			log.Warn("invalid scheduled operation %s : %s", f, err.Error())
+			continue
+		}
+		list = append(list, op)
+	}
+
+	sort.Slice(list, func(i, j int) bool { return list[i].ExecuteAt < list[j].ExecuteAt })
+	return list
+}
+
+func writeScheduledOperation(env fatima.FatimaEnv, op domain.ScheduledOperation) error {
+	dir := buildScheduleDir(env)
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		return fmt.Errorf("fail to make dir %s : %s", dir, err.Error())
+	}
+
+	b, err := json.MarshalIndent(op, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, op.Id+".json"), b, 0644)
+}
+
+func removeScheduledOperation(env fatima.FatimaEnv, id string) error {
+	return os.Remove(filepath.Join(buildScheduleDir(env), id+".json"))
+}
+
+// auditScheduledOperation 처리된 예약 작업을 audit 파일에 한 줄씩 기록한다
+func auditScheduledOperation(env fatima.FatimaEnv, op domain.ScheduledOperation) {
+	log.Warn("scheduled operation [%s] %s %s : %s %s", op.Id, op.Action, op.Target(), op.Status, op.Result)
+
+	b, err := json.Marshal(op)
+	if err != nil {
+		return
+	}
+
+	dir := buildScheduleDir(env)
+	_ = os.MkdirAll(dir, 0755)
+	auditFile := filepath.Join(dir, scheduleAuditFile)
+	file, err := os.OpenFile(auditFile, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		log.Warn("fail to write schedule audit : %s", err.Error())
+		return
+	}
+	_, _ = file.Write(append(b, '\n'))
+	file.Close()
+
+	if countJournalLines(auditFile) > scheduleAuditKeepCount+scheduleAuditCompactSlack {
+		err = trimLineFile(auditFile, scheduleAuditKeepCount)
+		if err != nil {
+			log.Warn("fail to compact schedule audit : %s", err.Error())
+		}
+	}
+}
+
+// readScheduleAudit 최근 처리된 예약 작업을 최신순으로 읽는다
+func readScheduleAudit(env fatima.FatimaEnv, limit int) []domain.ScheduledOperation {
+	list := make([]domain.ScheduledOperation, 0)
+	file, err := os.Open(filepath.Join(buildScheduleDir(env), scheduleAuditFile))
+	if err != nil {
+		return list
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		op := domain.ScheduledOperation{}
+		if json.Unmarshal(scanner.Bytes(), &op) == nil {
+			list = append(list, op)
+		}
+	}
+
+	if len(list) > limit {
+		list = list[len(list)-limit:]
+	}
+	for i, j := 0, len(list)-1; i < j; i, j = i+1, j-1 {
+		list[i], list[j] = list[j], list[i]
+	}
+	return list
+}
+
+func (service *DomainService) ScheduleOperation(loc *time.Location, req domain.ScheduleRequest) (domain.ScheduledOperation, error) {
+	op := domain.ScheduledOperation{}
+	err := req.Validate()
+	if err != nil {
+		return op, err
+	}
+
+	yamlConfig := builder.NewYamlFatimaPackageConfig(service.fatimaRuntime.GetEnv())
+	if len(req.Process) > 0 && yamlConfig.GetProcByName(req.Process) == nil {
+		return op, fmt.Errorf("not found process %s", req.Process)
+	}
+	if len(req.Group) > 0 && yamlConfig.GetGroupId(req.Group) < 0 {
+		return op, fmt.Errorf("not found group %s", req.Group)
+	}
+
+	executeAt, err := time.ParseInLocation(web.TIME_YYYYMMDDHHMMSS, req.ExecuteAt, loc)
+	if err != nil {
+		return op, fmt.Errorf("invalid execute_at %s : %s", req.ExecuteAt, err.Error())
+	}
+	now := time.Now()
+	if executeAt.Before(now) {
+		return op, fmt.Errorf("execute_at %s is past", req.ExecuteAt)
+	}
+	if executeAt.After(now.Add(scheduleMaxAhead)) {
+		return op, fmt.Errorf("execute_at %s is too far", req.ExecuteAt)
+	}
+
+	op.Id = strconv.FormatInt(now.UnixNano(), 36)
+	op.Action = req.Action
+	op.Process = req.Process
+	op.Group = req.Group
+	op.LogLevel = req.LogLevel
+	op.ExecuteAt = executeAt.UnixMilli()
+	op.Comment = req.Comment
+	op.Created = now.UnixMilli()
+	op.Status = domain.ScheduleStatusPending
+
+	scheduleMutex.Lock()
+	defer scheduleMutex.Unlock()
+	err = writeScheduledOperation(service.fatimaRuntime.GetEnv(), op)
+	if err != nil {
+		return op, err
+	}
+	log.Warn("scheduled operation [%s] %s %s at %s", op.Id, op.Action, op.Target(), executeAt.Format(web.TIME_YYYYMMDDHHMMSS))
+	return op, nil
+}
+
+func (service *DomainService) ListScheduledOperation() map[string]interface{} {
+	scheduleMutex.Lock()
+	defer scheduleMutex.Unlock()
+
+	report := make(map[string]interface{})
+	report["pending"] = readScheduledOperations(service.fatimaRuntime.GetEnv())
+	report["history"] = readScheduleAudit(service.fatimaRuntime.GetEnv(), scheduleAuditLimit)
+	return report
+}
+
+func (service *DomainService) CancelScheduledOperation(id string) error {
+	env := service.fatimaRuntime.GetEnv()
+
+	scheduleMutex.Lock()
+	defer scheduleMutex.Unlock()
+	for _, op := range readScheduledOperations(env) {
+		if op.Id != id {
+			continue
+		}
+		if op.Status == domain.ScheduleStatusRunning {
+			return fmt.Errorf("scheduled operation %s is running", id)
+		}
+		err := removeScheduledOperation(env, id)
+		if err != nil {
+			return err
+		}
+		op.Status = domain.ScheduleStatusCanceled
+		op.ExecutedAt = time.Now().UnixMilli()
+		auditScheduledOperation(env, op)
+		return nil
+	}
+	return fmt.Errorf("not found scheduled operation %s", id)
+}
+
+// ExecuteDueOperations 실행 시각이 도래한 예약 작업을 수행한다
+func ExecuteDueOperations(fatimaRuntime fatima.FatimaRuntime) {
+	env := fatimaRuntime.GetEnv()
+	now := time.Now()
+
+	scheduleMutex.Lock()
+	due := make([]domain.ScheduledOperation, 0)
+	for _, op := range readScheduledOperations(env) {
+		if op.Status == domain.ScheduleStatusRunning {
+			if !runningOperations[op.Id] {
+				// 실행 도중 juno 가 종료된 작업은 다시 실행하지 않는다
+				op.Status = domain.ScheduleStatusFail
+				op.Result = "interrupted while running"
+				finishScheduledOperation(env, op)
+			}
+			continue
+		}
+		if op.ExecuteAt > now.UnixMilli() {
+			continue
+		}
+		// 중복 실행되지 않도록 실행중으로 표시하고 audit 기록 후에 제거한다
+		op.Status = domain.ScheduleStatusRunning
+		op.ExecutedAt = time.Now().UnixMilli()
+		if err := writeScheduledOperation(env, op); err != nil {
+			log.Warn("fail to mark scheduled operation %s running : %s", op.Id, err.Error())
+			continue
+		}
+		runningOperations[op.Id] = true
+		due = append(due, op)
+	}
+	scheduleMutex.Unlock()
+
+	service := NewDomainService(fatimaRuntime).WithActor(domain.EventActorSchedule)
+	for _, op := range due {
+		if now.Sub(time.UnixMilli(op.ExecuteAt)) > scheduleMisfireGrace {
+			op.Status = domain.ScheduleStatusMissed
+			op.Result = fmt.Sprintf("missed execution time over %s", scheduleMisfireGrace)
+		} else {
+			op.Status, op.Result = service.executeScheduledOperation(op)
+		}
+
+		scheduleMutex.Lock()
+		finishScheduledOperation(env, op)
+		delete(runningOperations, op.Id)
+		scheduleMutex.Unlock()
+	}
+}
+
+// finishScheduledOperation 처리 결과를 audit 에 기록한 뒤 예약 파일을 제거한다
+func finishScheduledOperation(env fatima.FatimaEnv, op domain.ScheduledOperation) {
+	auditScheduledOperation(env, op)
+	if err := removeScheduledOperation(env, op.Id); err != nil {
+		log.Warn("fail to remove scheduled operation %s : %s", op.Id, err.Error())
+	}
+}
+
+func (service *DomainService) executeScheduledOperation(op domain.ScheduledOperation) (string, string) {
+	log.Warn("execute scheduled operation [%s] %s %s", op.Id, op.Action, op.Target())
+	switch op.Action {
+	case domain.ScheduleActionStart:
+		return summarizeOperationReport(service.StartProcess(false, op.Group, op.Process))
+	case domain.ScheduleActionStop:
+		return summarizeOperationReport(service.StopProcess(false, op.Group, op.Process))
+	case domain.ScheduleActionRestart:
+		status, result := summarizeOperationReport(service.StopProcess(false, op.Group, op.Process))
+		if status != domain.ScheduleStatusSuccess {
+			return status, result
+		}
+		service.waitProcessesStopped(op.Group, op.Process, restartStopDeadline)
+		status, started := summarizeOperationReport(service.StartProcess(false, op.Group, op.Process))
+		return status, result + "\n" + started
+	case domain.ScheduleActionLogLevel:
+		return summarizeOperationReport(service.ChangeLogLevel(op.Process, op.LogLevel))
+	}
+	return domain.ScheduleStatusFail, fmt.Sprintf("unknown action %s", op.Action)
+}
+
+// waitProcessesStopped 대상 프로세스가 모두 종료될 때까지 기다린다
+func (service *DomainService) waitProcessesStopped(group, proc string, deadline time.Duration) {
+	env := service.fatimaRuntime.GetEnv()
+	yamlConfig := builder.NewYamlFatimaPackageConfig(env)
+	target := make([]fatima.FatimaPkgProc, 0)
+	if len(group) > 0 {
+		target = yamlConfig.GetProcByGroup(group)
+	} else if p := yamlConfig.GetProcByName(proc); p != nil {
+		target = append(target, p)
+	}
+	waitTargetsStopped(env, target, deadline)
+}
+
+func waitTargetsStopped(env fatima.FatimaEnv, target []fatima.FatimaPkgProc, deadline time.Duration) {
+	until := time.Now().Add(deadline)
+	for time.Now().Before(until) {
+		alive := false
+		table := ScanProcessTable()
+		for _, p := range target {
+			pid := GetPidWithTable(env, p, table).Pid
+			if pid > 0 && inspector.CheckProcessRunningByPid(p.GetName(), pid) {
+				alive = true
+				break
+			}
+		}
+		if !alive {
+			return
+		}
+		time.Sleep(500 * time.Millisecond)
+	}
+	log.Warn("some processes are still alive after %s", deadline)
+}
+
+func summarizeOperationReport(report map[string]interface{}) (string, string) {
+	if v, ok := report["system"]; ok {
+		if res, ok := v.(web.SystemResponse); ok {
+			return domain.ScheduleStatusFail, res.Message
+		}
+	}
+
+	message := ""
+	if summary, ok := report["summary"].(map[string]string); ok {
+		message = strings.TrimSpace(summary["message"])
+	}
+	if results, ok := report["results"].(domain.ProcessResults); ok {
+		if results.HasFailure() {
+			return domain.ScheduleStatusFail, message
+		}
+		return domain.ScheduleStatusSuccess, message
+	}
+	if strings.Contains(message, "FAIL") || strings.Contains(message, "not found") {
+		return domain.ScheduleStatusFail, message
+	}
+	return domain.ScheduleStatusSuccess, message
+}
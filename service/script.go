@@ -0,0 +1,363 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 5:51
+ */
+
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/fatima-go/fatima-core"
+	"github.com/fatima-go/fatima-core/builder"
+	"github.com/fatima-go/fatima-log"
+	"github.com/fatima-go/juno/domain"
+)
+
+const (
+	// $FATIMA_HOME/app/<proc>/scripts 폴더의 실행 파일만 운영 스크립트로 허용한다
+	scriptFolder      = "scripts"
+	scriptDataDir     = "script"
+	scriptHistoryFile = "history.log"
+	// history 는 최근 keep 개만 보관하며 slack 만큼 넘으면 정리한다
+	scriptHistoryKeepCount    = 1000
+	scriptHistoryCompactSlack = 100
+	// 스크립트 기본 실행 제한 시간 (초)
+	propScriptTimeout    = "script.timeout.sec"
+	defaultScriptTimeout = 60
+	// 요청으로 지정할 수 있는 최대 실행 제한 시간 (초)
+	propScriptTimeoutMax    = "script.timeout.max.sec"
+	defaultScriptTimeoutMax = 600
+	// stdout, stderr 각각 보관하는 최대 크기
+	scriptOutputLimit = 64 * 1024
+	// 스크립트 설명을 찾는 최대 줄 수
+	scriptDescriptionLines = 10
+)
+
+var (
+	scriptTimeout    = defaultScriptTimeout
+	scriptTimeoutMax = defaultScriptTimeoutMax
+
+	scriptNamePattern        = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`)
+	scriptDescriptionPattern = regexp.MustCompile(`(?i)^#\s*description\s*:\s*(.+)$`)
+
+	scriptMutex    sync.Mutex
+	runningScripts = make(map[string]bool)
+)
+
+func loadScriptConfig(config fatima.Config) {
+	if v, err := config.GetInt(propScriptTimeout); err == nil && v > 0 {
+		scriptTimeout = v
+	}
+	if v, err := config.GetInt(propScriptTimeoutMax); err == nil && v > 0 {
+		scriptTimeoutMax = v
+	}
+}
+
+func buildScriptDir(env fatima.FatimaEnv, proc string) string {
+	return filepath.Join(getAppDir(env, proc), scriptFolder)
+}
+
+// discoverScripts scripts 폴더에서 실행 권한이 있는 파일들을 찾는다
+func discoverScripts(dir string) []domain.ScriptCommand {
+	list := make([]domain.ScriptCommand, 0)
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return list
+	}
+
+	for _, entry := range entries {
+		path := filepath.Join(dir, entry.Name())
+		fi, err := os.Stat(path)
+		if err != nil || !fi.Mode().IsRegular() || fi.Mode().Perm()&0111 == 0 {
+			continue
+		}
+
+		name := strings.TrimSuffix(entry.Name(), ".sh")
+		if !scriptNamePattern.MatchString(name) {
+			continue
+		}
+		list = append(list, domain.ScriptCommand{
+			Name:        name,
+			Description: readScriptDescription(path),
+			File:        entry.Name(),
+		})
+	}
+
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	return list
+}
+
+// readScriptDescription 스크립트 앞부분의 "# description: ..." 주석을 설명으로 사용한다
+func readScriptDescription(path string) string {
+	file, err := os.Open(path)
+	if err != nil {
+		return ""
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for i := 0; i < scriptDescriptionLines && scanner.Scan(); i++ {
+		m := scriptDescriptionPattern.FindStringSubmatch(strings.TrimSpace(scanner.Text()))
+		if len(m) > 1 {
+			return strings.TrimSpace(m[1])
+		}
+	}
+	return ""
+}
+
+// resolveScriptPath 스크립트의 실제 경로가 scripts 폴더 안에 있는지 확인한다
+func resolveScriptPath(dir string, script domain.ScriptCommand) (string, error) {
+	realDir, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		return "", fmt.Errorf("invalid script dir : %s", err.Error())
+	}
+	realPath, err := filepath.EvalSymlinks(filepath.Join(dir, script.File))
+	if err != nil {
+		return "", fmt.Errorf("invalid script : %s", err.Error())
+	}
+	if filepath.Dir(realPath) != realDir {
+		return "", fmt.Errorf("script %s is not in %s", script.Name, dir)
+	}
+	return realPath, nil
+}
+
+func (service *DomainService) ListScripts(proc string) ([]domain.ScriptCommand, error) {
+	env := service.fatimaRuntime.GetEnv()
+	yamlConfig := builder.NewYamlFatimaPackageConfig(env)
+	if yamlConfig.GetProcByName(proc) == nil {
+		return nil, fmt.Errorf("not found process %s", proc)
+	}
+	return discoverScripts(buildScriptDir(env, proc)), nil
+}
+
+func (service *DomainService) RunScript(req domain.ScriptRunRequest, client string) (domain.ScriptRunResult, error) {
+	log.Info("RunScript. req=[%v], client=[%s]", req, client)
+
+	result := domain.ScriptRunResult{Process: req.Process, Command: req.Command, Args: req.Args, Client: client}
+	err := req.Validate()
+	if err != nil {
+		return result, err
+	}
+
+	env := service.fatimaRuntime.GetEnv()
+	yamlConfig := builder.NewYamlFatimaPackageConfig(env)
+	proc := yamlConfig.GetProcByName(req.Process)
+	if proc == nil {
+		return result, fmt.Errorf("not found process %s", req.Process)
+	}
+
+	dir := buildScriptDir(env, proc.GetName())
+	var script *domain.ScriptCommand
+	for _, s := range discoverScripts(dir) {
+		if s.Name == req.Command {
+			script = &s
+			break
+		}
+	}
+	if script == nil {
+		return result, fmt.Errorf("not found script %s for %s", req.Command, proc.GetName())
+	}
+
+	path, err := resolveScriptPath(dir, *script)
+	if err != nil {
+		return result, err
+	}
+
+	timeout := scriptTimeout
+	if req.TimeoutSec > 0 {
+		timeout = req.TimeoutSec
+	}
+	if timeout > scriptTimeoutMax {
+		return result, fmt.Errorf("timeout must be less than %d sec", scriptTimeoutMax)
+	}
+
+	key := proc.GetName() + "/" + script.Name
+	scriptMutex.Lock()
+	if runningScripts[key] {
+		scriptMutex.Unlock()
+		return result, fmt.Errorf("script %s is already running", key)
+	}
+	runningScripts[key] = true
+	scriptMutex.Unlock()
+	defer func() {
+		scriptMutex.Lock()
+		delete(runningScripts, key)
+		scriptMutex.Unlock()
+	}()
+
+	cmd := exec.Command(path, req.Args...)
+	cmd.Dir = getAppDir(env, proc.GetName())
+	cmd.Env = os.Environ()
+	// 스크립트는 앱과 같은 계정으로 실행한다
+	err = applyLaunchCredential(env, proc.GetName(), cmd, readLaunchSpec(env, proc.GetName()))
+	if err != nil {
+		return result, fmt.Errorf("fail to apply credential : %s", err.Error())
+	}
+
+	executeScript(cmd, time.Duration(timeout)*time.Second, &result)
+	recordScriptHistory(env, result)
+	return result, nil
+}
+
+// executeScript 제한 시간이 지나면 스크립트의 process group 전체를 종료한다
+func executeScript(cmd *exec.Cmd, timeout time.Duration, result *domain.ScriptRunResult) {
+	stdout := &cappedBuffer{limit: scriptOutputLimit}
+	stderr := &cappedBuffer{limit: scriptOutputLimit}
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	setProcessGroup(cmd)
+
+	begin := time.Now()
+	result.Started = begin.UnixMilli()
+	result.ExitCode = -1
+
+	err := cmd.Start()
+	if err != nil {
+		result.Error = err.Error()
+		return
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- cmd.Wait()
+	}()
+
+	timer := time.NewTimer(timeout)
+	select {
+	case err = <-done:
+		timer.Stop()
+	case <-timer.C:
+		result.TimedOut = true
+		log.Warn("script %s timeout. kill process group %d", cmd.Path, cmd.Process.Pid)
+		_ = syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+		err = <-done
+	}
+
+	result.DurationMs = time.Since(begin).Milliseconds()
+	if cmd.ProcessState != nil {
+		result.ExitCode = cmd.ProcessState.ExitCode()
+	}
+	if err != nil {
+		result.Error = err.Error()
+	}
+	result.Stdout = stdout.String()
+	result.Stderr = stderr.String()
+	result.Truncated = stdout.truncated || stderr.truncated
+}
+
+// cappedBuffer limit 을 넘는 출력은 버리고 truncated 로 표시한다
+type cappedBuffer struct {
+	buf       bytes.Buffer
+	limit     int
+	truncated bool
+}
+
+func (b *cappedBuffer) Write(p []byte) (int, error) {
+	remain := b.limit - b.buf.Len()
+	if remain < len(p) {
+		b.truncated = true
+		if remain > 0 {
+			b.buf.Write(p[:remain])
+		}
+		return len(p), nil
+	}
+	return b.buf.Write(p)
+}
+
+func (b *cappedBuffer) String() string {
+	return b.buf.String()
+}
+
+// recordScriptHistory 스크립트 실행 이력을 한 줄씩 기록한다 (output 제외)
+func recordScriptHistory(env fatima.FatimaEnv, result domain.ScriptRunResult) {
+	log.Warn("script [%s/%s] executed by %s : exit=%d, timeout=%t, duration=%dms",
+		result.Process, result.Command, result.Client, result.ExitCode, result.TimedOut, result.DurationMs)
+
+	result.Stdout = ""
+	result.Stderr = ""
+	b, err := json.Marshal(result)
+	if err != nil {
+		return
+	}
+
+	dir := filepath.Join(env.GetFolderGuide().GetDataFolder(), scriptDataDir)
+	_ = os.MkdirAll(dir, 0755)
+	historyFile := filepath.Join(dir, scriptHistoryFile)
+
+	scriptMutex.Lock()
+	defer scriptMutex.Unlock()
+	file, err := os.OpenFile(historyFile, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		log.Warn("fail to write script history : %s", err.Error())
+		return
+	}
+	_, _ = file.Write(append(b, '\n'))
+	file.Close()
+
+	if countJournalLines(historyFile) > scriptHistoryKeepCount+scriptHistoryCompactSlack {
+		err = trimLineFile(historyFile, scriptHistoryKeepCount)
+		if err != nil {
+			log.Warn("fail to compact script history : %s", err.Error())
+		}
+	}
+}
+
+// ListScriptHistory 최근 스크립트 실행 이력을 최신순으로 읽는다
+func (service *DomainService) ListScriptHistory(proc string, limit int) []domain.ScriptRunResult {
+	list := make([]domain.ScriptRunResult, 0)
+	file, err := os.Open(filepath.Join(service.fatimaRuntime.GetEnv().GetFolderGuide().GetDataFolder(),
+		scriptDataDir, scriptHistoryFile))
+	if err != nil {
+		return list
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		r := domain.ScriptRunResult{}
+		if json.Unmarshal(scanner.Bytes(), &r) != nil {
+			continue
+		}
+		if len(proc) > 0 && r.Process != proc {
+			continue
+		}
+		list = append(list, r)
+	}
+
+	if limit > 0 && len(list) > limit {
+		list = list[len(list)-limit:]
+	}
+	for i, j := 0, len(list)-1; i < j; i, j = i+1, j-1 {
+		list[i], list[j] = list[j], list[i]
+	}
+	return list
+}
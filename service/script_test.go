@@ -0,0 +1,69 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 5:51
+ */
+
+package service
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/fatima-go/juno/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiscoverScripts(t *testing.T) {
+	dir := t.TempDir()
+	assert.Nil(t, os.WriteFile(filepath.Join(dir, "flush-cache.sh"),
+		[]byte("#!/bin/sh\n# description: flush local cache\necho flushed\n"), 0755))
+	assert.Nil(t, os.WriteFile(filepath.Join(dir, "readme.txt"), []byte("not executable"), 0644))
+
+	list := discoverScripts(dir)
+	assert.Equal(t, 1, len(list))
+	assert.Equal(t, "flush-cache", list[0].Name)
+	assert.Equal(t, "flush local cache", list[0].Description)
+
+	path, err := resolveScriptPath(dir, list[0])
+	assert.Nil(t, err)
+
+	result := domain.ScriptRunResult{}
+	executeScript(exec.Command(path), 5*time.Second, &result)
+	assert.Equal(t, 0, result.ExitCode)
+	assert.Equal(t, "flushed\n", result.Stdout)
+}
+
+func TestExecuteScriptTimeout(t *testing.T) {
+	result := domain.ScriptRunResult{}
+	executeScript(exec.Command("/bin/sh", "-c", "sleep 10 & sleep 10"), 200*time.Millisecond, &result)
+	assert.True(t, result.TimedOut)
+	assert.NotEqual(t, 0, result.ExitCode)
+	assert.Less(t, result.DurationMs, int64(5000))
+}
+
+func TestCappedBuffer(t *testing.T) {
+	b := &cappedBuffer{limit: 4}
+	n, err := b.Write([]byte("abcdef"))
+	assert.Nil(t, err)
+	assert.Equal(t, 6, n)
+	assert.Equal(t, "abcd", b.String())
+	assert.True(t, b.truncated)
+}
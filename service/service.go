@@ -26,6 +26,7 @@ import (
 	"strings"
 
 	"github.com/fatima-go/fatima-core"
+	"github.com/fatima-go/juno/domain"
 )
 
 const (
@@ -43,6 +44,8 @@ type DomainService struct {
 	fatimaRuntime fatima.FatimaRuntime
 	ListenAddress string
 	UrlSeed       string
+	// 제어 명령의 이벤트 기록에 남길 주체. 비어 있다면 운영자 요청으로 본다
+	actor string
 	//ValidateToken(token string, role domain.Role) error
 }
 
@@ -52,6 +55,20 @@ func NewDomainService(fatimaRuntime fatima.FatimaRuntime) *DomainService {
 	return &service
 }
 
+// WithActor 이벤트 기록 주체를 지정한 DomainService 를 만든다
+func (service *DomainService) WithActor(actor string) *DomainService {
+	clone := *service
+	clone.actor = actor
+	return &clone
+}
+
+func (service *DomainService) eventActor() string {
+	if len(service.actor) == 0 {
+		return domain.EventActorOperator
+	}
+	return service.actor
+}
+
 func (service *DomainService) getGatewayAddress(suffix string) string {
 	v, ok := service.fatimaRuntime.GetConfig().GetValue(PropGatewayServerAddress)
 	if ok {
@@ -0,0 +1,181 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 5:38
+ */
+
+package service
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/fatima-go/fatima-core"
+	"github.com/fatima-go/fatima-core/builder"
+	"github.com/fatima-go/fatima-log"
+	"github.com/fatima-go/juno/infra"
+	"github.com/fatima-go/juno/web"
+)
+
+const (
+	// signal 명령으로 전송 가능한 signal 목록. e.g) HUP,USR1,USR2
+	propProcessSignalAllow    = "process.signal.allow"
+	defaultProcessSignalAllow = "HUP,USR1,USR2"
+)
+
+var signalNames = map[string]syscall.Signal{
+	"HUP":   syscall.SIGHUP,
+	"INT":   syscall.SIGINT,
+	"QUIT":  syscall.SIGQUIT,
+	"KILL":  syscall.SIGKILL,
+	"USR1":  syscall.SIGUSR1,
+	"USR2":  syscall.SIGUSR2,
+	"TERM":  syscall.SIGTERM,
+	"CONT":  syscall.SIGCONT,
+	"STOP":  syscall.SIGSTOP,
+	"TSTP":  syscall.SIGTSTP,
+	"WINCH": syscall.SIGWINCH,
+}
+
+var signalAllowList = parseSignalAllowList(defaultProcessSignalAllow)
+
+func loadSignalConfig(config fatima.Config) {
+	v, ok := config.GetValue(propProcessSignalAllow)
+	if ok {
+		signalAllowList = parseSignalAllowList(v)
+	}
+}
+
+func parseSignalAllowList(value string) map[syscall.Signal]bool {
+	allow := make(map[syscall.Signal]bool)
+	for _, token := range strings.Split(value, ",") {
+		token = strings.TrimSpace(token)
+		if len(token) == 0 {
+			continue
+		}
+		sig, err := parseSignal(token)
+		if err != nil {
+			log.Warn("invalid %s value : %s", propProcessSignalAllow, err.Error())
+			continue
+		}
+		allow[sig] = true
+	}
+	return allow
+}
+
+// parseSignal HUP, SIGHUP, 1 형태를 모두 허용한다
+func parseSignal(name string) (syscall.Signal, error) {
+	name = strings.ToUpper(strings.TrimSpace(name))
+	if n, err := strconv.Atoi(name); err == nil {
+		for _, sig := range signalNames {
+			if int(sig) == n {
+				return sig, nil
+			}
+		}
+		return 0, fmt.Errorf("unsupported signal : %s", name)
+	}
+
+	sig, ok := signalNames[strings.TrimPrefix(name, "SIG")]
+	if !ok {
+		return 0, fmt.Errorf("unsupported signal : %s", name)
+	}
+	return sig, nil
+}
+
+func (service *DomainService) PauseProcess(proc string) map[string]interface{} {
+	return service.signalProcessReport(proc, func(p fatima.FatimaPkgProc, pid int, table *infra.ProcessTable) string {
+		tree := collectProcessTree(p.GetName(), pid, table)
+		err := signalProcessTree(tree, syscall.SIGSTOP)
+		if err != nil {
+			return fmt.Sprintf("FAIL TO PAUSE %s[%d] : %s\n", p.GetName(), pid, err.Error())
+		}
+		log.Warn("%s(%d) paused : %s", p.GetName(), pid, formatProcessTree(tree))
+		return fmt.Sprintf("PAUSED %d\n", pid)
+	})
+}
+
+func (service *DomainService) ResumeProcess(proc string) map[string]interface{} {
+	return service.signalProcessReport(proc, func(p fatima.FatimaPkgProc, pid int, table *infra.ProcessTable) string {
+		tree := collectProcessTree(p.GetName(), pid, table)
+		err := signalProcessTree(tree, syscall.SIGCONT)
+		if err != nil {
+			return fmt.Sprintf("FAIL TO RESUME %s[%d] : %s\n", p.GetName(), pid, err.Error())
+		}
+		log.Warn("%s(%d) resumed : %s", p.GetName(), pid, formatProcessTree(tree))
+		return fmt.Sprintf("RESUMED %d\n", pid)
+	})
+}
+
+func (service *DomainService) SignalProcess(proc string, signal string) map[string]interface{} {
+	sig, err := parseSignal(signal)
+	if err != nil {
+		report := make(map[string]interface{})
+		report["system"] = web.SystemResponse{Code: 700, Message: err.Error()}
+		return report
+	}
+	if !signalAllowList[sig] {
+		report := make(map[string]interface{})
+		report["system"] = web.SystemResponse{Code: 700, Message: fmt.Sprintf("signal %s is not allowed", signal)}
+		return report
+	}
+
+	return service.signalProcessReport(proc, func(p fatima.FatimaPkgProc, pid int, _ *infra.ProcessTable) string {
+		err := syscall.Kill(pid, sig)
+		if err != nil {
+			return fmt.Sprintf("FAIL TO SEND %s TO %s[%d] : %s\n", sig, p.GetName(), pid, err.Error())
+		}
+		log.Warn("send %s to %s(%d)", sig, p.GetName(), pid)
+		return fmt.Sprintf("SENT %s TO %d\n", strings.ToUpper(sig.String()), pid)
+	})
+}
+
+func (service *DomainService) signalProcessReport(proc string, execute func(fatima.FatimaPkgProc, int, *infra.ProcessTable) string) map[string]interface{} {
+	report := make(map[string]interface{})
+
+	yamlConfig := builder.NewYamlFatimaPackageConfig(service.fatimaRuntime.GetEnv())
+	p := yamlConfig.GetProcByName(proc)
+	if p == nil {
+		report["system"] = web.SystemResponse{Code: 700, Message: "not found process"}
+		return report
+	}
+
+	comp := strings.ToLower(p.GetName())
+	if comp == "jupiter" || comp == "juno" {
+		report["system"] = web.SystemResponse{Code: 700, Message: fmt.Sprintf("%s is not permitted", p.GetName())}
+		return report
+	}
+
+	report["package_group"] = service.fatimaRuntime.GetPackaging().GetGroup()
+	report["package_host"] = service.fatimaRuntime.GetPackaging().GetHost()
+	summary := make(map[string]string)
+	summary["package_name"] = service.fatimaRuntime.GetPackaging().GetName()
+
+	message := fmt.Sprintf("\nPROCESS : %s\n", p.GetName())
+	table := ScanProcessTable()
+	pid := GetPidWithTable(service.fatimaRuntime.GetEnv(), p, table).Pid
+	if pid < 1 || !inspector.CheckProcessRunningByPid(p.GetName(), pid) {
+		message = message + "NOT RUNNING\n"
+	} else {
+		message = message + execute(p, pid, table)
+	}
+
+	summary["message"] = message
+	report["summary"] = summary
+	return report
+}
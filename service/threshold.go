@@ -0,0 +1,376 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 6:05
+ */
+
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/fatima-go/fatima-core"
+	"github.com/fatima-go/fatima-core/builder"
+	"github.com/fatima-go/fatima-core/monitor"
+	"github.com/fatima-go/fatima-log"
+	"github.com/fatima-go/juno/domain"
+)
+
+const (
+	AlarmCategoryThreshold = "threshold"
+
+	thresholdDataDir = "threshold"
+	// 기본 임계치 규칙. 값이 0 이면 사용하지 않는다. 프로세스별 규칙은 data 폴더의 threshold/<proc>.json 에 저장한다
+	propThresholdCpuPercent = "threshold.cpu.percent"
+	propThresholdCpuSec     = "threshold.cpu.sec"
+	propThresholdCpuAction  = "threshold.cpu.action"
+	propThresholdRssMb      = "threshold.rss.mb"
+	propThresholdRssSec     = "threshold.rss.sec"
+	propThresholdRssAction  = "threshold.rss.action"
+	propThresholdFdPercent  = "threshold.fd.percent"
+	propThresholdFdSec      = "threshold.fd.sec"
+
+	actionRestartStopDeadline = 30 * time.Second
+)
+
+var (
+	thresholdMutex sync.Mutex
+	thresholdCache = make(map[string]*domain.ThresholdConfig)
+)
+
+var defaultThresholdConfig = domain.ThresholdConfig{Rules: []domain.ThresholdRule{}}
+
+func loadThresholdConfig(config fatima.Config) {
+	rules := make([]domain.ThresholdRule, 0)
+	add := func(metric, propValue, propSec, propAction string) {
+		v, err := config.GetInt(propValue)
+		if err != nil || v <= 0 {
+			return
+		}
+		rule := domain.ThresholdRule{Metric: metric, Value: float64(v)}
+		if sec, err := config.GetInt(propSec); err == nil {
+			rule.ForSec = sec
+		}
+		if len(propAction) > 0 {
+			rule.Action, _ = config.GetValue(propAction)
+		}
+		if err := rule.Validate(); err != nil {
+			log.Warn("invalid default threshold rule. ignored : %s", err.Error())
+			return
+		}
+		rules = append(rules, rule)
+	}
+
+	add(domain.ThresholdCpu, propThresholdCpuPercent, propThresholdCpuSec, propThresholdCpuAction)
+	add(domain.ThresholdRss, propThresholdRssMb, propThresholdRssSec, propThresholdRssAction)
+	add(domain.ThresholdFdPercent, propThresholdFdPercent, propThresholdFdSec, "")
+	defaultThresholdConfig = domain.ThresholdConfig{Rules: rules}
+}
+
+func buildThresholdFile(env fatima.FatimaEnv, proc string) string {
+	return filepath.Join(env.GetFolderGuide().GetDataFolder(), thresholdDataDir, proc+".json")
+}
+
+// readThresholdConfig 프로세스별 규칙이 없으면 기본 규칙을 사용한다
+// 모니터가 scan 마다 참조하므로 읽은 결과는 변경(UpdateThresholdConfig)전까지 캐시한다
+func readThresholdConfig(env fatima.FatimaEnv, proc string) (domain.ThresholdConfig, bool) {
+	thresholdMutex.Lock()
+	defer thresholdMutex.Unlock()
+	if cached, ok := thresholdCache[proc]; ok {
+		if cached == nil {
+			return defaultThresholdConfig, false
+		}
+		return *cached, true
+	}
+
+	config, err := loadThresholdFile(buildThresholdFile(env, proc))
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Warn("%s invalid threshold config : %s", proc, err.Error())
+		}
+		thresholdCache[proc] = nil
+		return defaultThresholdConfig, false
+	}
+	thresholdCache[proc] = &config
+	return config, true
+}
+
+func loadThresholdFile(file string) (domain.ThresholdConfig, error) {
+	config := domain.ThresholdConfig{}
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return config, err
+	}
+	err = json.Unmarshal(b, &config)
+	if err != nil {
+		return config, err
+	}
+	return config, config.Validate()
+}
+
+func invalidateThresholdConfig(proc string) {
+	thresholdMutex.Lock()
+	defer thresholdMutex.Unlock()
+	delete(thresholdCache, proc)
+}
+
+func removeThresholdConfig(env fatima.FatimaEnv, proc string) {
+	_ = os.Remove(buildThresholdFile(env, proc))
+	invalidateThresholdConfig(proc)
+}
+
+func (service *DomainService) GetThresholdConfig(proc string) (domain.ThresholdConfig, bool, error) {
+	env := service.fatimaRuntime.GetEnv()
+	if builder.NewYamlFatimaPackageConfig(env).GetProcByName(proc) == nil {
+		return domain.ThresholdConfig{}, false, fmt.Errorf("not found process %s", proc)
+	}
+	config, custom := readThresholdConfig(env, proc)
+	return config, custom, nil
+}
+
+// UpdateThresholdConfig config 가 nil 이면 프로세스별 규칙을 삭제하고 기본 규칙을 따른다
+// 규칙이 비어있는 config 를 저장하면 해당 프로세스는 임계치 알람을 사용하지 않는다
+func (service *DomainService) UpdateThresholdConfig(proc string, config *domain.ThresholdConfig) error {
+	log.Info("UpdateThresholdConfig. proc=[%s], config=[%v]", proc, config)
+
+	env := service.fatimaRuntime.GetEnv()
+	if builder.NewYamlFatimaPackageConfig(env).GetProcByName(proc) == nil {
+		return fmt.Errorf("not found process %s", proc)
+	}
+
+	file := buildThresholdFile(env, proc)
+	defer invalidateThresholdConfig(proc)
+	if config == nil {
+		err := os.Remove(file)
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+
+	err := config.Validate()
+	if err != nil {
+		return err
+	}
+	if config.Rules == nil {
+		config.Rules = []domain.ThresholdRule{}
+	}
+
+	err = os.MkdirAll(filepath.Dir(file), 0755)
+	if err != nil {
+		return fmt.Errorf("fail to make dir %s : %s", filepath.Dir(file), err.Error())
+	}
+	b, err := json.MarshalIndent(config, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(file, b, 0644)
+}
+
+// thresholdState 규칙별 초과 지속 상태
+type thresholdState struct {
+	since int64 // 초과가 시작된 시각 (unix millis)
+	fired bool
+}
+
+// thresholdEvent 평가 결과 발생한 알람
+type thresholdEvent struct {
+	breach    domain.ThresholdBreach
+	recovered bool
+}
+
+// evaluateThresholds 측정값을 규칙과 비교하여 지속 시간을 넘긴 초과와 해소를 리턴한다
+func evaluateThresholds(states map[string]*thresholdState, rules []domain.ThresholdRule, metric domain.ProcessMetric, now int64) ([]domain.ThresholdBreach, []thresholdEvent) {
+	breaches := make([]domain.ThresholdBreach, 0)
+	events := make([]thresholdEvent, 0)
+	active := make(map[string]bool)
+
+	for _, rule := range rules {
+		key := rule.Key()
+		active[key] = true
+		value, ok := rule.Measure(metric)
+		if !ok {
+			continue
+		}
+
+		state, exist := states[key]
+		if value <= rule.Value {
+			if exist && state.fired {
+				events = append(events, thresholdEvent{breach: domain.ThresholdBreach{Rule: rule, Since: state.since, Value: value}, recovered: true})
+			}
+			delete(states, key)
+			continue
+		}
+
+		if !exist {
+			state = &thresholdState{since: now}
+			states[key] = state
+		}
+		if now-state.since < int64(rule.ForSec)*1000 {
+			continue
+		}
+
+		breach := domain.ThresholdBreach{Rule: rule, Since: state.since, Value: value}
+		breaches = append(breaches, breach)
+		if !state.fired {
+			state.fired = true
+			events = append(events, thresholdEvent{breach: breach})
+		}
+	}
+
+	// 삭제/변경된 규칙의 상태는 정리한다
+	for key := range states {
+		if !active[key] {
+			delete(states, key)
+		}
+	}
+	return breaches, events
+}
+
+func thresholdAlarmLevel(level string) monitor.AlarmLevel {
+	switch level {
+	case domain.ThresholdLevelMajor:
+		return monitor.AlamLevelMajor
+	case domain.ThresholdLevelMinor:
+		return monitor.AlarmLevelMinor
+	}
+	return monitor.AlarmLevelWarn
+}
+
+// reflectThresholds 실행중인 프로세스의 측정값으로 임계치를 평가하고 알람과 조치를 수행한다
+func (p *processMonitor) reflectThresholds(item *domain.ProcessInfo, now int64) {
+	if !item.IsRunning() || item.Metric == nil {
+		delete(p.thresholds, item.Name)
+		return
+	}
+
+	if _, ok := findMaintenance(p.fatimaRuntime.GetEnv(), item.Name, item.Group); ok {
+		delete(p.thresholds, item.Name)
+		return
+	}
+
+	config, _ := readThresholdConfig(p.fatimaRuntime.GetEnv(), item.Name)
+	states, ok := p.thresholds[item.Name]
+	if !ok {
+		states = make(map[string]*thresholdState)
+		p.thresholds[item.Name] = states
+	}
+
+	breaches, events := evaluateThresholds(states, config.Rules, *item.Metric, now)
+	if len(breaches) > 0 {
+		item.Thresholds = breaches
+	}
+
+	for _, event := range events {
+		rule := event.breach.Rule
+		if event.recovered {
+			log.Info("[%s] threshold recovered : %s", item.Name, event.breach.Text())
+			msg := fmt.Sprintf("리소스 임계치 해소 : [%s] %s", item.Name, event.breach.Text())
+			raiseAlarm(monitor.AlarmLevelMinor, AlarmCategoryThreshold, domain.AlarmEventThresholdRecover, item.Name, msg)
+			continue
+		}
+
+		log.Warn("[%s] threshold exceeded : %s", item.Name, event.breach.Text())
+		msg := fmt.Sprintf("리소스 임계치 초과 : [%s] %s", item.Name, event.breach.Text())
+		if len(rule.Action) > 0 {
+			msg = fmt.Sprintf("%s\n조치 : %s", msg, rule.Action)
+		}
+		raiseAlarm(thresholdAlarmLevel(rule.Level), AlarmCategoryThreshold, domain.AlarmEventThresholdExceeded, item.Name, msg)
+
+		switch rule.Action {
+		case domain.ThresholdActionRestart:
+			go p.restartByAction(item.Name, "threshold")
+		case domain.ThresholdActionDump:
+			go p.dumpByAction(item.Name, "threshold")
+		}
+	}
+}
+
+// restartByAction 임계치, heartbeat 등의 조치로 프로세스를 중지 후 다시 기동한다.
+// 자동 재기동과 동일하게 재기동 정책(never, max_retry window, backoff)을 적용하고 재기동 이력에 기록한다
+func (p *processMonitor) restartByAction(proc, reason string) {
+	env := p.fatimaRuntime.GetEnv()
+	pkgProc := builder.NewYamlFatimaPackageConfig(env).GetProcByName(proc)
+	if pkgProc == nil {
+		return
+	}
+
+	policy, _ := readRestartPolicy(env, proc)
+	if policy.Mode == domain.RestartNever {
+		log.Info("[%s] restart policy is never. skip restart by %s", proc, reason)
+		return
+	}
+
+	record, delay, ok := p.reserveRestart(proc, policy)
+	if !ok {
+		log.Info("[%s] restart by %s is not permitted by restart policy", proc, reason)
+		return
+	}
+	log.Info("[%s] restart by %s after %s", proc, reason, delay)
+	time.Sleep(delay)
+
+	// 중지부터 기동까지 모니터의 자동 재기동이 겹치지 않도록 예약 상태를 유지한다
+	p.monMutex.Lock()
+	record.nextRestart = time.Now().Add(actionRestartStopDeadline).UnixMilli()
+	p.monMutex.Unlock()
+	defer func() {
+		p.monMutex.Lock()
+		record.nextRestart = 0
+		p.monMutex.Unlock()
+	}()
+
+	result := stopProcess(env, pkgProc, ScanProcessTable())
+	if result.Outcome != domain.OutcomeSuccess {
+		log.Warn("[%s] fail to stop by %s : %s %s", proc, reason, result.Outcome, result.Error)
+		return
+	}
+	NewDomainService(p.fatimaRuntime).waitProcessesStopped("", proc, actionRestartStopDeadline)
+
+	p.monMutex.Lock()
+	record.history = append(record.history, time.Now().UnixMilli())
+	if procInfo, ok := p.procMap[proc]; ok {
+		procInfo.AddICount()
+		p.procMap[proc] = procInfo
+	}
+	p.monMutex.Unlock()
+
+	pid, err := ExecuteProgram(env, pkgProc)
+	if err != nil {
+		log.Warn("[%s] fail to start by %s : %s", proc, reason, err.Error())
+		return
+	}
+	RecordProcessEvent(env, domain.ProcessEvent{Process: proc, Event: domain.ProcessEventRestarted, Pid: pid,
+		Actor: domain.EventActorMonitor, Reason: reason})
+}
+
+// dumpByAction 임계치, heartbeat 등의 조치로 stack dump 를 수집한다
+func (p *processMonitor) dumpByAction(proc, reason string) {
+	dump, err := NewDomainService(p.fatimaRuntime).DumpProcess(proc, false)
+	if err != nil {
+		// java 외의 프로세스는 dump 로 종료될 수 있어 자동 조치로 수행하지 않는다. 조치가 동작하지 않았음을 알린다
+		log.Warn("[%s] fail to dump by %s : %s", proc, reason, err.Error())
+		msg := fmt.Sprintf("%s 조치(dump) 실패 : [%s] %s", reason, proc, err.Error())
+		raiseAlarm(monitor.AlarmLevelWarn, AlarmCategoryMonitor, domain.AlarmEventDumpFailed, proc, msg)
+		return
+	}
+	log.Info("[%s] dump by %s : %s", proc, reason, dump.File)
+}
@@ -0,0 +1,72 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 6:05
+ */
+
+package service
+
+import (
+	"testing"
+
+	"github.com/fatima-go/juno/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvaluateThresholds(t *testing.T) {
+	rules := []domain.ThresholdRule{
+		{Metric: domain.ThresholdRss, Value: 2048, ForSec: 300, Action: domain.ThresholdActionRestart},
+		{Metric: domain.ThresholdFdPercent, Value: 80, ForSec: 0},
+	}
+	states := make(map[string]*thresholdState)
+	metric := domain.ProcessMetric{CpuPercent: 10, RssKb: 3 * 1024 * 1024, FDCount: 900, FDLimit: 1024, Threads: 10}
+
+	// fd 는 즉시, rss 는 지속 시간 이전이므로 발생하지 않는다
+	breaches, events := evaluateThresholds(states, rules, metric, 1000)
+	assert.Equal(t, 1, len(breaches))
+	assert.Equal(t, 1, len(events))
+	assert.Equal(t, domain.ThresholdFdPercent, events[0].breach.Rule.Metric)
+
+	breaches, events = evaluateThresholds(states, rules, metric, 1000+300*1000)
+	assert.Equal(t, 2, len(breaches))
+	assert.Equal(t, 1, len(events))
+	assert.Equal(t, domain.ThresholdRss, events[0].breach.Rule.Metric)
+	assert.Equal(t, int64(1000), events[0].breach.Since)
+
+	// 이미 알람이 발생한 초과는 다시 알리지 않는다
+	_, events = evaluateThresholds(states, rules, metric, 1000+400*1000)
+	assert.Equal(t, 0, len(events))
+
+	metric.RssKb = 1024
+	breaches, events = evaluateThresholds(states, rules, metric, 1000+500*1000)
+	assert.Equal(t, 1, len(breaches))
+	assert.Equal(t, 1, len(events))
+	assert.True(t, events[0].recovered)
+
+	// fd limit 을 모르면 fd_percent 는 평가하지 않는다
+	metric.FDLimit = -1
+	breaches, _ = evaluateThresholds(states, rules, metric, 1000+600*1000)
+	assert.Equal(t, 0, len(breaches))
+}
+
+func TestThresholdConfigValidate(t *testing.T) {
+	assert.Nil(t, domain.ThresholdConfig{Rules: []domain.ThresholdRule{{Metric: "cpu", Value: 90, ForSec: 600, Action: "dump"}}}.Validate())
+	assert.NotNil(t, domain.ThresholdConfig{Rules: []domain.ThresholdRule{{Metric: "disk", Value: 90}}}.Validate())
+	assert.NotNil(t, domain.ThresholdConfig{Rules: []domain.ThresholdRule{{Metric: "cpu", Value: 90, Action: "kill"}}}.Validate())
+	dup := domain.ThresholdRule{Metric: "fd", Value: 1000}
+	assert.NotNil(t, domain.ThresholdConfig{Rules: []domain.ThresholdRule{dup, dup}}.Validate())
+}
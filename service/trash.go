@@ -0,0 +1,675 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 5:47
+ */
+
+package service
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fatima-go/fatima-core"
+	"github.com/fatima-go/fatima-core/builder"
+	"github.com/fatima-go/fatima-log"
+	"github.com/fatima-go/juno/domain"
+)
+
+const (
+	trashDataDir      = "trash"
+	trashManifestFile = "manifest.json"
+	trashFileSuffix   = ".tar.gz"
+	trashTimeFormat   = "20060102-150405"
+	// unregist 된 프로세스 아카이브 보관 기간 (일)
+	propTrashKeepDay    = "trash.keep.day"
+	defaultTrashKeepDay = 30
+	// unregist 전 프로세스 종료를 기다리는 최대 시간
+	unregistStopDeadline = 30 * time.Second
+)
+
+const (
+	trashSectionLog      = "log"
+	trashSectionRevision = "revision"
+	trashSectionData     = "data"
+	trashSectionHistory  = "history"
+	trashSectionMetrics  = "metrics"
+)
+
+var (
+	trashKeepDay = defaultTrashKeepDay
+	trashMutex   sync.Mutex
+)
+
+// trashManifest 아카이브에 함께 저장되는 등록 정보. restore 시 재등록에 사용한다
+type trashManifest struct {
+	Process    builder.ProcessItem `json:"process"`
+	Group      string              `json:"group"`
+	LogLevel   string              `json:"loglevel,omitempty"`
+	LaunchSpec *domain.LaunchSpec  `json:"launch_spec,omitempty"`
+	// 프로세스별로 지정된 경우에만 보관한다
+	RestartPolicy *domain.RestartPolicy   `json:"restart_policy,omitempty"`
+	Threshold     *domain.ThresholdConfig `json:"threshold,omitempty"`
+	Revision      string                  `json:"revision,omitempty"` // app 링크가 가리키던 revision 폴더명
+	Created       int64                   `json:"created"`
+}
+
+func loadTrashConfig(config fatima.Config) {
+	if v, err := config.GetInt(propTrashKeepDay); err == nil && v > 0 {
+		trashKeepDay = v
+	}
+}
+
+func buildTrashDir(env fatima.FatimaEnv) string {
+	return filepath.Join(env.GetFolderGuide().GetDataFolder(), trashDataDir)
+}
+
+// buildTrashSections 아카이브 대상 폴더들 (section 이름 -> 실제 경로)
+func buildTrashSections(env fatima.FatimaEnv, proc string) map[string]string {
+	fatimaDir := env.GetFolderGuide().GetFatimaHome()
+	return map[string]string{
+		trashSectionLog:      filepath.Join(fatimaDir, builder.FatimaFolderLog, proc),
+		trashSectionRevision: filepath.Join(fatimaDir, builder.FatimaFolderApp, domain.FOLDER_APP_REVISION, proc),
+		trashSectionData:     filepath.Join(fatimaDir, builder.FatimaFolderData, proc),
+		trashSectionHistory:  buildHistorySaveDir(env, proc),
+		trashSectionMetrics:  buildMetricDir(env, proc),
+	}
+}
+
+// archiveProcess 프로세스 관련 폴더들을 trash 폴더에 tarball 로 보관한다
+func archiveProcess(env fatima.FatimaEnv, manifest trashManifest) (string, error) {
+	trashDir := buildTrashDir(env)
+	err := os.MkdirAll(trashDir, 0755)
+	if err != nil {
+		return "", fmt.Errorf("fail to make dir %s : %s", trashDir, err.Error())
+	}
+
+	name := manifest.Process.Name
+	file := buildTrashFile(trashDir, name, time.UnixMilli(manifest.Created))
+	err = writeTrashArchive(file, manifest, buildTrashSections(env, name))
+	if err != nil {
+		return "", err
+	}
+	return file, nil
+}
+
+// buildTrashFile <name>.<yyyyMMdd-HHmmss>.tar.gz 형식이며 같은 초에 이미 아카이브가 있다면 -<seq> 를 덧붙인다
+func buildTrashFile(trashDir string, name string, created time.Time) string {
+	stamp := created.Format(trashTimeFormat)
+	file := filepath.Join(trashDir, fmt.Sprintf("%s.%s%s", name, stamp, trashFileSuffix))
+	for seq := 1; ; seq++ {
+		if _, err := os.Stat(file); os.IsNotExist(err) {
+			return file
+		}
+		file = filepath.Join(trashDir, fmt.Sprintf("%s.%s-%d%s", name, stamp, seq, trashFileSuffix))
+	}
+}
+
+// parseTrashFile 아카이브 파일명에서 프로세스명, 생성 시각, seq 를 분리한다
+func parseTrashFile(base string) (string, string, int, bool) {
+	if !strings.HasSuffix(base, trashFileSuffix) {
+		return "", "", 0, false
+	}
+	body := strings.TrimSuffix(base, trashFileSuffix)
+	idx := strings.LastIndex(body, ".")
+	if idx < 1 {
+		return "", "", 0, false
+	}
+
+	name, stamp, seq := body[:idx], body[idx+1:], 0
+	if len(stamp) > len(trashTimeFormat) {
+		if stamp[len(trashTimeFormat)] != '-' || !isDigits(stamp[len(trashTimeFormat)+1:]) {
+			return "", "", 0, false
+		}
+		seq, _ = strconv.Atoi(stamp[len(trashTimeFormat)+1:])
+		stamp = stamp[:len(trashTimeFormat)]
+	}
+	if _, err := time.Parse(trashTimeFormat, stamp); err != nil {
+		return "", "", 0, false
+	}
+	return name, stamp, seq, true
+}
+
+func writeTrashArchive(file string, manifest trashManifest, sections map[string]string) error {
+	tmpFile := file + ".tmp"
+	f, err := os.Create(tmpFile)
+	if err != nil {
+		return fmt.Errorf("fail to create archive : %s", err.Error())
+	}
+
+	err = writeTrashEntries(f, manifest, sections)
+	closeErr := f.Close()
+	if err == nil && closeErr != nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(tmpFile)
+		return fmt.Errorf("fail to write archive : %s", err.Error())
+	}
+	return os.Rename(tmpFile, file)
+}
+
+func writeTrashEntries(w io.Writer, manifest trashManifest, sections map[string]string) error {
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+
+	b, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	err = tw.WriteHeader(&tar.Header{
+		Name:     trashManifestFile,
+		Mode:     0644,
+		Size:     int64(len(b)),
+		ModTime:  time.UnixMilli(manifest.Created),
+		Typeflag: tar.TypeReg,
+	})
+	if err != nil {
+		return err
+	}
+	if _, err = tw.Write(b); err != nil {
+		return err
+	}
+
+	for _, section := range sortedSectionNames(sections) {
+		err = addTrashSection(tw, section, sections[section])
+		if err != nil {
+			return fmt.Errorf("%s : %s", section, err.Error())
+		}
+	}
+
+	if err = tw.Close(); err != nil {
+		return err
+	}
+	return gw.Close()
+}
+
+func sortedSectionNames(sections map[string]string) []string {
+	names := make([]string, 0, len(sections))
+	for k := range sections {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func addTrashSection(tw *tar.Writer, section string, root string) error {
+	if _, err := os.Lstat(root); os.IsNotExist(err) {
+		return nil
+	}
+
+	return filepath.Walk(root, func(path string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+
+		link := ""
+		if fi.Mode()&os.ModeSymlink != 0 {
+			link, err = os.Readlink(path)
+			if err != nil {
+				return err
+			}
+		}
+
+		header, err := tar.FileInfoHeader(fi, link)
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(filepath.Join(section, rel))
+		if fi.IsDir() {
+			header.Name += "/"
+		}
+		if err = tw.WriteHeader(header); err != nil {
+			return err
+		}
+		if !fi.Mode().IsRegular() {
+			return nil
+		}
+
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.CopyN(tw, f, header.Size)
+		return err
+	})
+}
+
+func openTrashArchive(file string) (*os.File, *tar.Reader, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, nil, err
+	}
+	gr, err := gzip.NewReader(f)
+	if err != nil {
+		_ = f.Close()
+		return nil, nil, fmt.Errorf("invalid archive %s : %s", file, err.Error())
+	}
+	return f, tar.NewReader(gr), nil
+}
+
+func readTrashManifest(file string) (trashManifest, error) {
+	manifest := trashManifest{}
+	f, tr, err := openTrashArchive(file)
+	if err != nil {
+		return manifest, err
+	}
+	defer f.Close()
+
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return manifest, fmt.Errorf("invalid archive %s : %s", file, err.Error())
+		}
+		if header.Name != trashManifestFile {
+			continue
+		}
+		b, err := io.ReadAll(tr)
+		if err != nil {
+			return manifest, err
+		}
+		err = json.Unmarshal(b, &manifest)
+		if err != nil {
+			return manifest, fmt.Errorf("invalid manifest : %s", err.Error())
+		}
+		return manifest, nil
+	}
+	return manifest, fmt.Errorf("not found manifest in %s", file)
+}
+
+// extractTrashArchive 아카이브의 각 section 을 원래 경로로 복원한다
+func extractTrashArchive(file string, sections map[string]string) error {
+	f, tr, err := openTrashArchive(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("invalid archive %s : %s", file, err.Error())
+		}
+
+		name := strings.TrimSuffix(header.Name, "/")
+		idx := strings.Index(name, "/")
+		section, rel := name, "."
+		if idx > 0 {
+			section, rel = name[:idx], name[idx+1:]
+		}
+		root, ok := sections[section]
+		if !ok {
+			continue
+		}
+		rel = filepath.Clean(filepath.FromSlash(rel))
+		if rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) || filepath.IsAbs(rel) {
+			return fmt.Errorf("invalid entry in archive : %s", header.Name)
+		}
+
+		err = extractTrashEntry(tr, header, filepath.Join(root, rel))
+		if err != nil {
+			return fmt.Errorf("fail to extract %s : %s", header.Name, err.Error())
+		}
+	}
+}
+
+func extractTrashEntry(tr *tar.Reader, header *tar.Header, target string) error {
+	mode := os.FileMode(header.Mode).Perm()
+	switch header.Typeflag {
+	case tar.TypeDir:
+		return os.MkdirAll(target, mode|0700)
+	case tar.TypeSymlink:
+		if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+			return err
+		}
+		return os.Symlink(header.Linkname, target)
+	case tar.TypeReg:
+		if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+			return err
+		}
+		f, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, mode)
+		if err != nil {
+			return err
+		}
+		_, err = io.Copy(f, tr)
+		closeErr := f.Close()
+		if err != nil {
+			return err
+		}
+		if closeErr != nil {
+			return closeErr
+		}
+		return os.Chtimes(target, header.ModTime, header.ModTime)
+	}
+	return nil
+}
+
+// resolveTrashFile 아카이브 파일명 혹은 프로세스명(가장 최근 아카이브)으로 아카이브 경로를 찾는다
+func resolveTrashFile(env fatima.FatimaEnv, name string) (string, error) {
+	trashDir := buildTrashDir(env)
+	if strings.HasSuffix(name, trashFileSuffix) {
+		file := filepath.Join(trashDir, filepath.Base(name))
+		if _, err := os.Stat(file); err != nil {
+			return "", fmt.Errorf("not found archive %s", name)
+		}
+		return file, nil
+	}
+
+	files, err := filepath.Glob(filepath.Join(trashDir, name+".*"+trashFileSuffix))
+	if err != nil {
+		return "", fmt.Errorf("not found archive for process %s", name)
+	}
+
+	// name 뒤에 . 이 붙은 다른 프로세스(name.sub)의 아카이브는 제외한다
+	latest, latestStamp, latestSeq := "", "", -1
+	for _, f := range files {
+		proc, stamp, seq, ok := parseTrashFile(filepath.Base(f))
+		if !ok || proc != name {
+			continue
+		}
+		if stamp > latestStamp || (stamp == latestStamp && seq > latestSeq) {
+			latest, latestStamp, latestSeq = f, stamp, seq
+		}
+	}
+	if len(latest) == 0 {
+		return "", fmt.Errorf("not found archive for process %s", name)
+	}
+	return latest, nil
+}
+
+func (service *DomainService) UnregistProcess(proc string) (domain.TrashEntry, error) {
+	env := service.fatimaRuntime.GetEnv()
+	yamlConfig := builder.NewYamlFatimaPackageConfig(env)
+	comp := strings.ToLower(proc)
+	found := -1
+
+	for i, p := range yamlConfig.Processes {
+		if comp == strings.ToLower(p.GetName()) {
+			found = i
+			break
+		}
+	}
+
+	if found < 0 {
+		// not found
+		return domain.TrashEntry{}, fmt.Errorf("not found process %s", proc)
+	}
+
+	item := yamlConfig.Processes[found]
+	proc = item.GetName()
+
+	// stop process before archiving
+	result := stopProcess(env, yamlConfig.GetProcByName(proc), ScanProcessTable())
+	switch result.Outcome {
+	case domain.OutcomeNotPermitted:
+		return domain.TrashEntry{}, fmt.Errorf("%s is not permitted for unregist", proc)
+	case domain.OutcomeFail:
+		return domain.TrashEntry{}, fmt.Errorf("fail to stop %s : %s", proc, result.Error)
+	case domain.OutcomeSuccess:
+		recordProcessResults(env, domain.ProcessResults{result}, service.eventActor(), "unregist")
+		service.waitProcessesStopped("", proc, unregistStopDeadline)
+		pid := GetPid(env, yamlConfig.GetProcByName(proc))
+		if pid > 0 && inspector.CheckProcessRunningByPid(proc, pid) {
+			return domain.TrashEntry{}, fmt.Errorf("%s[%d] is still running", proc, pid)
+		}
+	}
+
+	manifest := trashManifest{Process: item, Created: time.Now().UnixMilli()}
+	for _, g := range yamlConfig.Groups {
+		if g.Id == item.Gid {
+			manifest.Group = g.Name
+			break
+		}
+	}
+	manifest.LogLevel = service.readLogLevels()[proc]
+	if spec := readLaunchSpec(env, proc); !spec.IsEmpty() {
+		manifest.LaunchSpec = &spec
+	}
+	if policy, custom := readRestartPolicy(env, proc); custom {
+		manifest.RestartPolicy = &policy
+	}
+	if config, custom := readThresholdConfig(env, proc); custom {
+		manifest.Threshold = &config
+	}
+	if link, err := os.Readlink(getAppDir(env, proc)); err == nil {
+		manifest.Revision = filepath.Base(link)
+	}
+
+	trashMutex.Lock()
+	file, err := archiveProcess(env, manifest)
+	trashMutex.Unlock()
+	if err != nil {
+		return domain.TrashEntry{}, fmt.Errorf("fail to archive %s : %s", proc, err.Error())
+	}
+	log.Warn("%s archived to %s", proc, file)
+
+	packageMutex.Lock()
+	defer packageMutex.Unlock()
+	yamlConfig = builder.NewYamlFatimaPackageConfig(env)
+	found = -1
+	for i, p := range yamlConfig.Processes {
+		if proc == p.GetName() {
+			found = i
+			break
+		}
+	}
+	if found < 0 {
+		return buildTrashEntry(file, manifest), fmt.Errorf("%s is already unregisted", proc)
+	}
+	yamlConfig.Processes = append(yamlConfig.Processes[:found], yamlConfig.Processes[found+1:]...)
+	yamlConfig.Save()
+
+	// reflect loglevel
+	m := service.readLogLevels()
+	delete(m, proc)
+	service.writeLogLevels(m)
+
+	// remove per-process settings (launch spec, restart policy, threshold, metrics)
+	removeLaunchSpec(env, proc)
+	removeRestartPolicy(env, proc)
+	removeThresholdConfig(env, proc)
+	removeProcessMetrics(env, proc)
+
+	// unlink app
+	unlinkApp(env, proc)
+
+	// remove archived dirs (log, revision, data, deployment history, metrics)
+	for section, dir := range buildTrashSections(env, proc) {
+		_ = os.RemoveAll(dir)
+		log.Debug("removed %s dir : %s", section, dir)
+	}
+
+	return buildTrashEntry(file, manifest), nil
+}
+
+func buildTrashEntry(file string, manifest trashManifest) domain.TrashEntry {
+	entry := domain.TrashEntry{
+		File:     filepath.Base(file),
+		Process:  manifest.Process.Name,
+		Group:    manifest.Group,
+		Revision: manifest.Revision,
+		Created:  manifest.Created,
+		Expire:   time.UnixMilli(manifest.Created).AddDate(0, 0, trashKeepDay).UnixMilli(),
+	}
+	if fi, err := os.Stat(file); err == nil {
+		entry.Size = fi.Size()
+	}
+	return entry
+}
+
+// RestoreProcess trash 에 보관된 아카이브로 프로세스를 재등록하고 파일들을 복원한다
+func (service *DomainService) RestoreProcess(name string) (domain.TrashEntry, error) {
+	log.Info("RestoreProcess. name=[%s]", name)
+
+	env := service.fatimaRuntime.GetEnv()
+	trashMutex.Lock()
+	defer trashMutex.Unlock()
+	packageMutex.Lock()
+	defer packageMutex.Unlock()
+
+	file, err := resolveTrashFile(env, name)
+	if err != nil {
+		return domain.TrashEntry{}, err
+	}
+
+	manifest, err := readTrashManifest(file)
+	if err != nil {
+		return domain.TrashEntry{}, err
+	}
+	proc := manifest.Process.Name
+	if len(proc) == 0 {
+		return domain.TrashEntry{}, fmt.Errorf("invalid manifest : empty process name")
+	}
+
+	yamlConfig := builder.NewYamlFatimaPackageConfig(env)
+	if isExistProc(proc, yamlConfig) {
+		return domain.TrashEntry{}, fmt.Errorf("aleady exist process %s", proc)
+	}
+
+	gid := yamlConfig.GetGroupId(manifest.Group)
+	if gid < 0 {
+		if !yamlConfig.IsValidGroupId(manifest.Process.Gid) {
+			return domain.TrashEntry{}, fmt.Errorf("not found group %s", manifest.Group)
+		}
+		gid = manifest.Process.Gid
+	}
+
+	sections := buildTrashSections(env, proc)
+	for _, dir := range sections {
+		if _, err := os.Lstat(dir); err == nil {
+			return domain.TrashEntry{}, fmt.Errorf("already exist %s", dir)
+		}
+	}
+	if _, err := os.Lstat(getAppDir(env, proc)); err == nil {
+		return domain.TrashEntry{}, fmt.Errorf("already exist %s", getAppDir(env, proc))
+	}
+
+	err = extractTrashArchive(file, sections)
+	if err != nil {
+		for _, dir := range sections {
+			_ = os.RemoveAll(dir)
+		}
+		return domain.TrashEntry{}, err
+	}
+
+	item := manifest.Process
+	item.Gid = gid
+	yamlConfig.Processes = append(yamlConfig.Processes, item)
+	yamlConfig.Save()
+
+	if len(manifest.LogLevel) > 0 {
+		m := service.readLogLevels()
+		m[proc] = manifest.LogLevel
+		service.writeLogLevels(m)
+	}
+
+	if manifest.LaunchSpec != nil {
+		err = writeLaunchSpec(env, proc, *manifest.LaunchSpec)
+		if err != nil {
+			log.Warn("fail to restore launch spec : %s", err.Error())
+		}
+	}
+
+	if manifest.RestartPolicy != nil {
+		err = service.UpdateRestartPolicy(proc, manifest.RestartPolicy)
+		if err != nil {
+			log.Warn("fail to restore restart policy : %s", err.Error())
+		}
+	}
+
+	if manifest.Threshold != nil {
+		err = service.UpdateThresholdConfig(proc, manifest.Threshold)
+		if err != nil {
+			log.Warn("fail to restore threshold : %s", err.Error())
+		}
+	}
+
+	if len(manifest.Revision) > 0 {
+		err = linkRevision(env, proc, filepath.Join(sections[trashSectionRevision], manifest.Revision))
+		if err != nil {
+			log.Warn("fail to restore app link : %s", err.Error())
+		}
+	}
+
+	entry := buildTrashEntry(file, manifest)
+	_ = os.Remove(file)
+	log.Warn("%s restored from %s", proc, file)
+	return entry, nil
+}
+
+// ListTrash trash 에 보관중인 아카이브 목록
+func (service *DomainService) ListTrash() []domain.TrashEntry {
+	list := make([]domain.TrashEntry, 0)
+	files, err := filepath.Glob(filepath.Join(buildTrashDir(service.fatimaRuntime.GetEnv()), "*"+trashFileSuffix))
+	if err != nil {
+		return list
+	}
+
+	sort.Strings(files)
+	for _, file := range files {
+		manifest, err := readTrashManifest(file)
+		if err != nil {
+			log.Warn("skip trash file : %s", err.Error())
+			continue
+		}
+		list = append(list, buildTrashEntry(file, manifest))
+	}
+	return list
+}
+
+// StripTrash 보관 기간이 지난 아카이브를 삭제한다
+func StripTrash(env fatima.FatimaEnv) {
+	trashMutex.Lock()
+	defer trashMutex.Unlock()
+
+	files, err := filepath.Glob(filepath.Join(buildTrashDir(env), "*"+trashFileSuffix+"*"))
+	if err != nil {
+		return
+	}
+
+	expire := time.Now().AddDate(0, 0, -trashKeepDay)
+	for _, file := range files {
+		fi, err := os.Stat(file)
+		if err != nil || fi.ModTime().After(expire) {
+			continue
+		}
+		log.Info("remove expired trash : %s", file)
+		_ = os.Remove(file)
+	}
+}
@@ -0,0 +1,124 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 5:47
+ */
+
+package service
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/fatima-go/fatima-core/builder"
+	"github.com/fatima-go/juno/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrashArchiveRoundTrip(t *testing.T) {
+	src := t.TempDir()
+	logDir := filepath.Join(src, "log", "sample")
+	revDir := filepath.Join(src, "revision", "sample")
+	assert.Nil(t, os.MkdirAll(filepath.Join(logDir, "sub"), 0755))
+	assert.Nil(t, os.MkdirAll(filepath.Join(revDir, "2026.10.19-19.00_R001"), 0755))
+	assert.Nil(t, os.WriteFile(filepath.Join(logDir, "sub", "sample.log"), []byte("hello"), 0644))
+	assert.Nil(t, os.WriteFile(filepath.Join(revDir, "2026.10.19-19.00_R001", "sample"), []byte("bin"), 0755))
+	assert.Nil(t, os.Symlink("2026.10.19-19.00_R001", filepath.Join(revDir, "current")))
+	metricDir := filepath.Join(src, "metrics", "sample")
+	assert.Nil(t, os.MkdirAll(metricDir, 0755))
+	assert.Nil(t, os.WriteFile(filepath.Join(metricDir, "raw.ring"), []byte("ring"), 0644))
+
+	manifest := trashManifest{
+		Process:       builder.ProcessItem{Gid: 2, Name: "sample"},
+		Group:         "svc",
+		RestartPolicy: &domain.RestartPolicy{Mode: domain.RestartNever},
+		Revision:      "2026.10.19-19.00_R001",
+		Created:       1760870700000,
+	}
+	file := filepath.Join(t.TempDir(), "sample.20261019-194500.tar.gz")
+	sections := map[string]string{
+		trashSectionLog:      logDir,
+		trashSectionRevision: revDir,
+		trashSectionData:     filepath.Join(src, "data", "sample"), // 없는 폴더는 무시
+		trashSectionMetrics:  metricDir,
+	}
+	assert.Nil(t, writeTrashArchive(file, manifest, sections))
+
+	read, err := readTrashManifest(file)
+	assert.Nil(t, err)
+	assert.Equal(t, "sample", read.Process.Name)
+	assert.Equal(t, "svc", read.Group)
+	assert.Equal(t, manifest.Revision, read.Revision)
+	assert.NotNil(t, read.RestartPolicy)
+	assert.Equal(t, domain.RestartNever, read.RestartPolicy.Mode)
+	assert.Nil(t, read.Threshold)
+
+	dst := t.TempDir()
+	restored := map[string]string{
+		trashSectionLog:      filepath.Join(dst, "log", "sample"),
+		trashSectionRevision: filepath.Join(dst, "revision", "sample"),
+		trashSectionData:     filepath.Join(dst, "data", "sample"),
+		trashSectionMetrics:  filepath.Join(dst, "metrics", "sample"),
+	}
+	assert.Nil(t, extractTrashArchive(file, restored))
+
+	b, err := os.ReadFile(filepath.Join(restored[trashSectionLog], "sub", "sample.log"))
+	assert.Nil(t, err)
+	assert.Equal(t, "hello", string(b))
+
+	fi, err := os.Stat(filepath.Join(restored[trashSectionRevision], "2026.10.19-19.00_R001", "sample"))
+	assert.Nil(t, err)
+	assert.Equal(t, os.FileMode(0755), fi.Mode().Perm())
+
+	link, err := os.Readlink(filepath.Join(restored[trashSectionRevision], "current"))
+	assert.Nil(t, err)
+	assert.Equal(t, "2026.10.19-19.00_R001", link)
+
+	b, err = os.ReadFile(filepath.Join(restored[trashSectionMetrics], "raw.ring"))
+	assert.Nil(t, err)
+	assert.Equal(t, "ring", string(b))
+
+	_, err = os.Stat(restored[trashSectionData])
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestTrashFileName(t *testing.T) {
+	dir := t.TempDir()
+	created := time.Date(2026, 10, 19, 19, 45, 0, 0, time.Local)
+
+	first := buildTrashFile(dir, "svc", created)
+	assert.Equal(t, filepath.Join(dir, "svc.20261019-194500.tar.gz"), first)
+	assert.Nil(t, os.WriteFile(first, []byte("a"), 0644))
+	second := buildTrashFile(dir, "svc", created)
+	assert.Equal(t, filepath.Join(dir, "svc.20261019-194500-1.tar.gz"), second)
+
+	name, stamp, seq, ok := parseTrashFile("svc.20261019-194500-1.tar.gz")
+	assert.True(t, ok)
+	assert.Equal(t, "svc", name)
+	assert.Equal(t, "20261019-194500", stamp)
+	assert.Equal(t, 1, seq)
+
+	// svc 의 glob 에 걸리는 svc.worker 아카이브는 다른 프로세스로 구분된다
+	name, _, _, ok = parseTrashFile("svc.worker.20261019-194500.tar.gz")
+	assert.True(t, ok)
+	assert.Equal(t, "svc.worker", name)
+
+	_, _, _, ok = parseTrashFile("svc.worker.tar.gz")
+	assert.False(t, ok)
+}
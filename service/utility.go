@@ -27,13 +27,14 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
 	"syscall"
 	"time"
 
 	"github.com/fatima-go/fatima-core"
 	"github.com/fatima-go/fatima-core/builder"
-	"github.com/fatima-go/fatima-core/lib"
 	"github.com/fatima-go/fatima-log"
+	"github.com/fatima-go/juno/infra"
 )
 
 func GetPid(env fatima.FatimaEnv, proc fatima.FatimaPkgProc) int {
@@ -44,6 +45,62 @@ func GetPid(env fatima.FatimaEnv, proc fatima.FatimaPkgProc) int {
 	return GetPidByGrep(grep)
 }
 
+// GetPidWithTable 이미 수집된 process table 을 이용하여 pid 를 찾는다 (grep 이 없다면 pid 파일)
+func GetPidWithTable(env fatima.FatimaEnv, proc fatima.FatimaPkgProc, table *infra.ProcessTable) infra.PidMatch {
+	grep := strings.Trim(proc.GetGrep(), "\n\r\t ")
+	if len(grep) == 0 {
+		pid := readPidFromFile(env, proc.GetName())
+		return infra.PidMatch{Pid: pid, Candidates: []int{pid}}
+	}
+	return findPidInTable(table, infra.NewProcessMatcher(grep))
+}
+
+var (
+	ambiguousMutex = sync.Mutex{}
+	ambiguousMatch = make(map[string]string)
+)
+
+func findPidInTable(table *infra.ProcessTable, matcher *infra.ProcessMatcher) infra.PidMatch {
+	match := table.Find(matcher)
+	changed := trackAmbiguousMatch(matcher.String(), match)
+	if !match.Ambiguous {
+		return match
+	}
+	if changed {
+		log.Warn("ambiguous pid match for [%s] : %v. choose %d", matcher, match.Candidates, match.Pid)
+	} else {
+		log.Debug("ambiguous pid match for [%s] : %v. choose %d", matcher, match.Candidates, match.Pid)
+	}
+	return match
+}
+
+// trackAmbiguousMatch 매 주기마다 경고가 반복되지 않도록 모호한 후보 목록이 바뀐 경우에만 true 를 반환한다
+func trackAmbiguousMatch(key string, match infra.PidMatch) bool {
+	ambiguousMutex.Lock()
+	defer ambiguousMutex.Unlock()
+
+	if !match.Ambiguous {
+		delete(ambiguousMatch, key)
+		return false
+	}
+
+	candidates := fmt.Sprintf("%v", match.Candidates)
+	if ambiguousMatch[key] == candidates {
+		return false
+	}
+	ambiguousMatch[key] = candidates
+	return true
+}
+
+// ScanProcessTable 현재 process table 을 수집한다. 여러 프로세스의 pid 를 찾을 때는 한번만 수집하여 공유한다
+func ScanProcessTable() *infra.ProcessTable {
+	table, err := infra.ScanProcessTable()
+	if err != nil {
+		log.Warn("%s", err.Error())
+	}
+	return table
+}
+
 func readPidFromFile(env fatima.FatimaEnv, procName string) int {
 	filePath := filepath.Join(
 		env.GetFolderGuide().GetFatimaHome(),
@@ -68,26 +125,7 @@ func readPidFromFile(env fatima.FatimaEnv, procName string) int {
 }
 
 func GetPidByGrep(grep string) int {
-	target := strings.Replace(grep, "-", "\\-", -1)
-	target = strings.Replace(target, "\"", "\\\"", -1)
-	command := fmt.Sprintf("ps -ef | grep \"%s\" | grep -v grep | awk '{print $2}'", target)
-	out, err := lib.ExecuteShell(command)
-	if err != nil {
-		log.Warn("fail to execute command : %s", err.Error())
-		return 0
-	}
-
-	s := strings.Trim(out, "\r\n\t ")
-	if len(s) < 1 {
-		return 0
-	}
-
-	pid, err := strconv.Atoi(s)
-	if err != nil {
-		log.Warn("invalid integer pid : %s", err.Error())
-		return 0
-	}
-	return pid
+	return findPidInTable(ScanProcessTable(), infra.NewProcessMatcher(grep)).Pid
 }
 
 func hasExecutingShell(env fatima.FatimaEnv, proc fatima.FatimaPkgProc) bool {
@@ -110,78 +148,73 @@ func hasExecutingShell(env fatima.FatimaEnv, proc fatima.FatimaPkgProc) bool {
 }
 
 func KillProgram(proc string, pid int) error {
-	log.Warn("try to kill %s [%d]", proc, pid)
+	_, err := killProgramTree(proc, pid)
+	return err
+}
+
+// killProgramTree pid 가 속한 process group 과 자손 프로세스 전체를 종료한다
+func killProgramTree(proc string, pid int) (ProcessTree, error) {
+	tree := collectProcessTree(proc, pid, ScanProcessTable())
+	log.Warn("try to kill %s [%d] : %s", proc, pid, formatProcessTree(tree))
 	GetProcessMonitor().ProcessStop(proc)
-	err := syscall.Kill(pid, syscall.SIGTERM)
+	err := signalProcessTree(tree, syscall.SIGTERM)
 	if err != nil {
 		log.Warn("kill %s(%d) fail. err=%s", proc, pid, err.Error())
 	} else {
 		log.Warn("%s(%d) was killed", proc, pid)
+		go watchLeftoverProcesses(proc, tree)
 	}
-	return err
+	return tree, err
 }
 
 func ExecuteProgram(env fatima.FatimaEnv, proc fatima.FatimaPkgProc) (int, error) {
 	GetProcessMonitor().ProcessStart(proc.GetName())
-	workingDir := filepath.Join(
-		env.GetFolderGuide().GetFatimaHome(),
-		builder.FatimaFolderApp,
-		proc.GetName())
+
+	spec := readLaunchSpec(env, proc.GetName())
+	cmd := buildProgramCommand(env, proc, spec)
+	setProcessGroup(cmd)
+	log.Info("Working Dir : %s", cmd.Dir)
+
+	err := applyLaunchCredential(env, proc.GetName(), cmd, spec)
+	if err != nil {
+		log.Warn("refuse to launch %s : %s", proc.GetName(), err.Error())
+		return 0, err
+	}
 
 	if hasExecutingShell(env, proc) {
+		// java(fatima-orient) 는 JVM pid 로 output 파일을 직접 만들므로 capture 하지 않는다
 		log.Info("executing java fatima program : %s", proc.GetName())
-		shell := filepath.Join(workingDir, proc.GetName()+".sh")
-		cmd := exec.Command(shell)
-		cmd.Dir = workingDir
-		log.Info("Working Dir : %s", workingDir)
-		err := cmd.Start()
+		log.Debug("executing : %s", formatCommandLine(cmd))
+		err = startCommand(cmd, spec)
 		if err != nil {
 			return 0, err
 		}
+		registerChild(cmd.Process.Pid, proc.GetName())
+		recordLaunchGroup(proc.GetName(), cmd.Process.Pid)
 		return grepJavaFatimaProgramPid(proc), nil
 	} else {
-		log.Info("executing native program : [%s], [%s]", proc.GetName(), proc.GetPath())
-		var cmd *exec.Cmd
-		if len(proc.GetPath()) > 0 {
-			cmd = exec.Command(proc.GetPath())
-		} else {
-			executing := filepath.Join(workingDir, proc.GetName())
-			log.Debug("executing : %s", executing)
-			cmd = exec.Command(executing)
+		output, err := prepareOutputCapture(env, proc.GetName(), cmd)
+		if err != nil {
+			log.Warn("%s", err.Error())
 		}
-		cmd.Dir = workingDir
-		log.Info("Working Dir : %s", workingDir)
-		err := cmd.Start()
+
+		log.Info("executing native program : [%s], [%s]", proc.GetName(), proc.GetPath())
+		log.Debug("executing : %s", formatCommandLine(cmd))
+		err = startCommand(cmd, spec)
+		startOutputCapture(env, proc.GetName(), cmd, output)
 		if err != nil {
 			return 0, err
 		}
 
+		registerChild(cmd.Process.Pid, proc.GetName())
+		recordLaunchGroup(proc.GetName(), cmd.Process.Pid)
 		return cmd.Process.Pid, nil
 	}
 }
 
 func grepJavaFatimaProgramPid(proc fatima.FatimaPkgProc) int {
 	time.Sleep(200 * time.Millisecond)
-
-	grep := fmt.Sprintf("psname=%s", proc.GetName())
-	command := fmt.Sprintf("ps -ef | grep \"%s\" | grep -v grep | awk '{print $2}'", grep)
-	out, err := lib.ExecuteShell(command)
-	if err != nil {
-		log.Warn("fail to execute : %s", err.Error())
-		return 0
-	} else if len(out) < 1 {
-		// not found
-		return 0
-	}
-
-	var pid int
-	pid, err = strconv.Atoi(strings.Trim(out, "\r\n"))
-	if err != nil {
-		log.Warn("fail to convert to int : %s[%s]", out, err.Error())
-		return 0
-	}
-
-	return pid
+	return findPidInTable(ScanProcessTable(), infra.NewPsNameMatcher(proc.GetName())).Pid
 }
 
 func grepNativeProgramPid(cmd *exec.Cmd, proc fatima.FatimaPkgProc) int {
@@ -189,23 +222,5 @@ func grepNativeProgramPid(cmd *exec.Cmd, proc fatima.FatimaPkgProc) int {
 		return cmd.Process.Pid
 	}
 	time.Sleep(200 * time.Millisecond)
-
-	command := fmt.Sprintf("ps -ef | grep \"%s\" | grep -v grep | awk '{print $2}'", proc.GetGrep())
-	out, err := lib.ExecuteShell(command)
-	if err != nil {
-		log.Warn("fail to execute : %s", err.Error())
-		return 0
-	} else if len(out) < 1 {
-		// not found
-		return 0
-	}
-
-	var pid int
-	pid, err = strconv.Atoi(out)
-	if err != nil {
-		log.Warn("fail to convert to int : %s[%s]", out, err.Error())
-		return 0
-	}
-
-	return pid
+	return findPidInTable(ScanProcessTable(), infra.NewProcessMatcher(proc.GetGrep())).Pid
 }
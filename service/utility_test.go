@@ -0,0 +1,45 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 6:38
+ */
+
+package service
+
+import (
+	"testing"
+
+	"github.com/fatima-go/juno/infra"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrackAmbiguousMatch(t *testing.T) {
+	key := "test.ambiguous.matcher"
+	defer trackAmbiguousMatch(key, infra.PidMatch{})
+
+	first := infra.PidMatch{Pid: 10, Candidates: []int{10, 20}, Ambiguous: true}
+	assert.True(t, trackAmbiguousMatch(key, first))
+	assert.False(t, trackAmbiguousMatch(key, first))
+
+	changed := infra.PidMatch{Pid: 10, Candidates: []int{10, 30}, Ambiguous: true}
+	assert.True(t, trackAmbiguousMatch(key, changed))
+	assert.False(t, trackAmbiguousMatch(key, changed))
+
+	// 모호함이 해소된 뒤 다시 발생하면 다시 경고한다
+	assert.False(t, trackAmbiguousMatch(key, infra.PidMatch{Pid: 10, Candidates: []int{10}}))
+	assert.True(t, trackAmbiguousMatch(key, changed))
+}
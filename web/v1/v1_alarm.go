@@ -0,0 +1,123 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 6:10
+ */
+
+package v1
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/fatima-go/fatima-log"
+	"github.com/fatima-go/juno/domain"
+	"github.com/fatima-go/juno/web"
+)
+
+func displayAlarmConfig(controller web.JunoWebServiceController, res http.ResponseWriter, req *http.Request) {
+	/*
+		{}
+		{"alarm": {"sinks": [{"name": "notify", "type": "notify"}, {"name": "ops", "type": "webhook", "url": "http://10.0.0.5/alarm", "levels": ["MAJOR"], "rate_limit": 10, "rate_period_sec": 60}]},
+		 "status": [{"name": "notify", "type": "notify", "sent": 12, "failed": 0, "rate_limited": 0}]}
+	*/
+	config, status := controller.GetAlarmConfig()
+	report := make(map[string]interface{})
+	report["alarm"] = config
+	report["status"] = status
+	b, err := json.Marshal(report)
+	if err != nil {
+		log.Warn("fail to build json response : %s", err.Error())
+		web.ResponseError(res, req, http.StatusInternalServerError, err.Error())
+		return
+	}
+	web.ResponseSuccess(res, req, string(b))
+}
+
+type alarmConfigRequest struct {
+	ClientAddress string              `json:"client_address"`
+	Alarm         *domain.AlarmConfig `json:"alarm"`
+}
+
+func changeAlarmConfig(controller web.JunoWebServiceController, res http.ResponseWriter, req *http.Request) {
+	/*
+		{"alarm": {"sinks": [{"name": "notify", "type": "notify", "levels": ["MINOR", "MAJOR"]},
+		                     {"name": "ops", "type": "webhook", "url": "http://10.0.0.5/alarm", "categories": ["monitor"], "processes": ["search*"], "rate_limit": 10, "rate_period_sec": 60},
+		                     {"name": "local", "type": "file", "path": "/home/fatima/data/alarm/sink/alarm.log", "template": "{{.Level}} {{.Process}} {{.Message}}"},
+		                     {"name": "pager", "type": "command", "command": "/home/fatima/app/juno/alarm/page.sh", "levels": ["MAJOR"], "timeout_sec": 10}]}}
+		{} : 설정을 삭제하고 기본 설정(notify)을 따른다
+		file : $FATIMA_HOME/data/alarm/sink 아래 경로만 허용
+		command : $FATIMA_HOME/app/juno/alarm 폴더의 실행 파일만 허용
+		headers : 조회 시 가려진 값(******)을 그대로 보내면 기존 값을 유지한다
+		{"system": {"message": "success", "code": 200}}
+	*/
+	b, err := io.ReadAll(req.Body)
+	if err != nil {
+		log.Warn("fail to read request data : %s", err.Error())
+		web.ResponseError(res, req, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	params := alarmConfigRequest{}
+	err = json.Unmarshal(b, &params)
+	if err != nil {
+		log.Warn("invalid read request data : %s", err.Error())
+		web.ResponseError(res, req, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if !controller.IsRemoteOperationAllowed(params.ClientAddress) {
+		log.Warn("remote operation is not allowed")
+		web.ResponseError(res, req, http.StatusForbidden, "remote operation is not allowed")
+		return
+	}
+
+	err = controller.UpdateAlarmConfig(params.Alarm)
+	if err != nil {
+		log.Warn("fail to change alarm config : %s", err.Error())
+		web.WriteSystemError(res, req, "fail to change alarm config : "+err.Error())
+		return
+	}
+	web.WriteSystemSuccess(res, req, "success")
+}
+
+func sendTestAlarm(controller web.JunoWebServiceController, res http.ResponseWriter, req *http.Request) {
+	/*
+		{"level": "MAJOR", "message": "webhook 연동 확인"}
+		{"system": {"message": "success", "code": 200}}
+	*/
+	params, err := parsingRequest(req)
+	if err != nil {
+		log.Warn("invalid parameter : %s", err.Error())
+		web.ResponseError(res, req, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if !controller.IsRemoteOperationAllowed(params["client_address"]) {
+		log.Warn("remote operation is not allowed")
+		web.ResponseError(res, req, http.StatusForbidden, "remote operation is not allowed")
+		return
+	}
+
+	err = controller.SendTestAlarm(params["level"], params["message"])
+	if err != nil {
+		web.ResponseError(res, req, http.StatusBadRequest, err.Error())
+		return
+	}
+	web.WriteSystemSuccess(res, req, "success")
+}
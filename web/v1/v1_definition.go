@@ -0,0 +1,143 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 5:50
+ */
+
+package v1
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/fatima-go/fatima-log"
+	"github.com/fatima-go/juno/domain"
+	"github.com/fatima-go/juno/web"
+)
+
+type processUpdateRequest struct {
+	domain.ProcessUpdate
+	ClientAddress string `json:"client_address"`
+}
+
+type groupUpdateRequest struct {
+	domain.GroupUpdate
+	ClientAddress string `json:"client_address"`
+}
+
+func displayDefinition(controller web.JunoWebServiceController, res http.ResponseWriter, req *http.Request) {
+	/*
+		{"definition": {"version": "3f2a...", "groups": [{"id": 1, "name": "OPM"}], "processes": [{"name": "ifbccard", "gid": 2, "group": "svc", ...}]}}
+	*/
+	def, err := controller.GetPackageDefinition()
+	if err != nil {
+		log.Warn("fail to read package definition : %s", err.Error())
+		web.WriteSystemError(res, req, err.Error())
+		return
+	}
+
+	report := make(map[string]interface{})
+	report["definition"] = def
+	writeDefinitionResponse(res, req, report)
+}
+
+func changeProcessDefinition(controller web.JunoWebServiceController, res http.ResponseWriter, req *http.Request) {
+	/*
+		{"version": "3f2a...", "process": "ifbccard", "group_id": "svc", "weight": 2, "path": "/usr/local/bin/ifbccard", "restart": true}
+		{"result": {"version": "9c1d...", "backup": "...", "process": {...}, "restart_required": false, "restarting": true}}
+	*/
+	b, err := io.ReadAll(req.Body)
+	if err != nil {
+		log.Warn("fail to read request data : %s", err.Error())
+		web.ResponseError(res, req, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	params := processUpdateRequest{}
+	err = json.Unmarshal(b, &params)
+	if err != nil {
+		log.Warn("invalid read request data : %s", err.Error())
+		web.ResponseError(res, req, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if !controller.IsRemoteOperationAllowed(params.ClientAddress) {
+		log.Warn("remote operation is not allowed")
+		web.ResponseError(res, req, http.StatusForbidden, "remote operation is not allowed")
+		return
+	}
+
+	result, err := controller.UpdateProcessDefinition(params.ProcessUpdate)
+	if err != nil {
+		log.Warn("fail to change process definition : %s", err.Error())
+		web.WriteSystemError(res, req, "fail to change process definition : "+err.Error())
+		return
+	}
+
+	report := make(map[string]interface{})
+	report["result"] = result
+	writeDefinitionResponse(res, req, report)
+}
+
+func changeGroupDefinition(controller web.JunoWebServiceController, res http.ResponseWriter, req *http.Request) {
+	/*
+		{"version": "3f2a...", "id": 5, "name": "batch"}
+		{"result": {"version": "9c1d...", "backup": "...", "group": {"id": 5, "name": "batch"}, "restart_required": false}}
+	*/
+	b, err := io.ReadAll(req.Body)
+	if err != nil {
+		log.Warn("fail to read request data : %s", err.Error())
+		web.ResponseError(res, req, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	params := groupUpdateRequest{}
+	err = json.Unmarshal(b, &params)
+	if err != nil {
+		log.Warn("invalid read request data : %s", err.Error())
+		web.ResponseError(res, req, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if !controller.IsRemoteOperationAllowed(params.ClientAddress) {
+		log.Warn("remote operation is not allowed")
+		web.ResponseError(res, req, http.StatusForbidden, "remote operation is not allowed")
+		return
+	}
+
+	result, err := controller.UpdateGroupDefinition(params.GroupUpdate)
+	if err != nil {
+		log.Warn("fail to change group definition : %s", err.Error())
+		web.WriteSystemError(res, req, "fail to change group definition : "+err.Error())
+		return
+	}
+
+	report := make(map[string]interface{})
+	report["result"] = result
+	writeDefinitionResponse(res, req, report)
+}
+
+func writeDefinitionResponse(res http.ResponseWriter, req *http.Request, report map[string]interface{}) {
+	b, err := json.Marshal(report)
+	if err != nil {
+		log.Warn("fail to build json response : %s", err.Error())
+		web.ResponseError(res, req, http.StatusInternalServerError, err.Error())
+		return
+	}
+	web.ResponseSuccess(res, req, string(b))
+}
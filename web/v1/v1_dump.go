@@ -0,0 +1,135 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 5:54
+ */
+
+package v1
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/fatima-go/fatima-log"
+	"github.com/fatima-go/juno/web"
+)
+
+func dumpProcess(controller web.JunoWebServiceController, res http.ResponseWriter, req *http.Request) {
+	/*
+		{"process": "ifbccard", "force": "true"}
+		{"dump": {"process": "ifbccard", "pid": 1234, "type": "java", "method": "sigquit", "file": "ifbccard.20261019-224000.1234.java.dump", "size": 10240, "created": 1760881200000, "content": "..."}}
+	*/
+	params, err := parsingRequest(req)
+	if err != nil {
+		log.Warn("invalid parameter : %s", err.Error())
+		web.ResponseError(res, req, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	clientAddress, _ := params["client_address"]
+	if !controller.IsRemoteOperationAllowed(clientAddress) {
+		log.Warn("remote operation is not allowed")
+		web.ResponseError(res, req, http.StatusForbidden, "remote operation is not allowed")
+		return
+	}
+
+	process, ok := params["process"]
+	if !ok {
+		web.ResponseError(res, req, http.StatusBadRequest, "invalid parameter : not found process")
+		return
+	}
+
+	dump, err := controller.DumpProcess(process, params["force"] == "true")
+	if err != nil {
+		log.Warn("fail to dump : %s", err.Error())
+		web.WriteSystemError(res, req, "fail to dump : "+err.Error())
+		return
+	}
+
+	report := make(map[string]interface{})
+	report["dump"] = dump
+	writeDumpResponse(res, req, report)
+}
+
+func listDumps(controller web.JunoWebServiceController, res http.ResponseWriter, req *http.Request) {
+	/*
+		{"process": "ifbccard"}
+		{"dumps": [{"process": "ifbccard", "pid": 1234, "type": "java", "method": "sigquit", "file": "...", "size": 10240, "created": 1760881200000}]}
+	*/
+	params, err := parsingRequest(req)
+	if err != nil {
+		log.Warn("invalid parameter : %s", err.Error())
+		web.ResponseError(res, req, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	process, ok := params["process"]
+	if !ok {
+		web.ResponseError(res, req, http.StatusBadRequest, "invalid parameter : not found process")
+		return
+	}
+
+	dumps, err := controller.ListDumps(process)
+	if err != nil {
+		web.WriteSystemError(res, req, err.Error())
+		return
+	}
+
+	report := make(map[string]interface{})
+	report["dumps"] = dumps
+	writeDumpResponse(res, req, report)
+}
+
+func getDump(controller web.JunoWebServiceController, res http.ResponseWriter, req *http.Request) {
+	/*
+		{"process": "ifbccard", "file": "ifbccard.20261019-224000.1234.java.dump"}
+		{"dump": {"process": "ifbccard", ..., "content": "..."}}
+	*/
+	params, err := parsingRequest(req)
+	if err != nil {
+		log.Warn("invalid parameter : %s", err.Error())
+		web.ResponseError(res, req, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	process, ok := params["process"]
+	file, ok2 := params["file"]
+	if !ok || !ok2 {
+		web.ResponseError(res, req, http.StatusBadRequest, "invalid parameter : process and file are required")
+		return
+	}
+
+	dump, err := controller.GetDump(process, file)
+	if err != nil {
+		web.WriteSystemError(res, req, err.Error())
+		return
+	}
+
+	report := make(map[string]interface{})
+	report["dump"] = dump
+	writeDumpResponse(res, req, report)
+}
+
+func writeDumpResponse(res http.ResponseWriter, req *http.Request, report map[string]interface{}) {
+	b, err := json.Marshal(report)
+	if err != nil {
+		log.Warn("fail to build json response : %s", err.Error())
+		web.ResponseError(res, req, http.StatusInternalServerError, err.Error())
+		return
+	}
+	web.ResponseSuccess(res, req, string(b))
+}
@@ -22,19 +22,37 @@ package v1
 
 import (
 	"encoding/json"
+	"io"
 	"net/http"
+	"strconv"
 
 	"github.com/fatima-go/fatima-log"
+	"github.com/fatima-go/juno/domain"
 	"github.com/fatima-go/juno/web"
 )
 
+type registRequest struct {
+	domain.ProcessRegistration
+	ClientAddress string `json:"client_address"`
+}
+
 func registProcess(controller web.JunoWebServiceController, res http.ResponseWriter, req *http.Request) {
 	/*
 		{"process": "ifbccard", "group_id": "4", "package": "xfp-dev"}
+		{"process": "worker3", "clone": "worker1", "weight": 2}
+		{"process": "ifbccard", "group_id": "svc", "loglevel": "debug", "hb": true, "startmode": 0, "weight": 1, "startsec": 5, "path": "", "grep": ""}
 		{"system": {"message": "success", "code": 200}}
 		{"system": {"message": "total 1 juno. process 1 registed", "code": 200}}
 	*/
-	params, err := parsingRequest(req)
+	b, err := io.ReadAll(req.Body)
+	if err != nil {
+		log.Warn("fail to read request data : %s", err.Error())
+		web.ResponseError(res, req, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	params := registRequest{}
+	err = json.Unmarshal(b, &params)
 	if err != nil {
 		log.Warn("invalid parameter : %s", err.Error())
 		web.ResponseError(res, req, http.StatusBadRequest, err.Error())
@@ -42,32 +60,22 @@ func registProcess(controller web.JunoWebServiceController, res http.ResponseWri
 	}
 
 	if log.IsDebugEnabled() {
-		log.Debug("regist process : %v", params)
+		log.Debug("regist process : %s", string(b))
 	}
 
-	clientAddress, _ := params["client_address"]
-	if !controller.IsRemoteOperationAllowed(clientAddress) {
+	if !controller.IsRemoteOperationAllowed(params.ClientAddress) {
 		log.Warn("remote operation is not allowed")
 		web.ResponseError(res, req, http.StatusForbidden, "remote operation is not allowed")
 		return
 	}
 
-	var process, group_id string
-	var ok bool
-	process, ok = params["process"]
-	if !ok {
+	if len(params.Process) == 0 {
 		log.Warn("not found process")
-		web.ResponseError(res, req, http.StatusBadRequest, err.Error())
-		return
-	}
-	group_id, ok = params["group_id"]
-	if !ok {
-		log.Warn("not found group_id")
-		web.ResponseError(res, req, http.StatusBadRequest, err.Error())
+		web.ResponseError(res, req, http.StatusBadRequest, "invalid parameter : not found process")
 		return
 	}
 
-	err = controller.RegistProcess(process, group_id)
+	err = controller.RegistProcess(params.ProcessRegistration)
 	if err != nil {
 		log.Warn("fail to regist : %s", err.Error())
 		web.WriteSystemError(res, req, "fail to regist : "+err.Error())
@@ -178,13 +186,65 @@ func unregistProcess(controller web.JunoWebServiceController, res http.ResponseW
 		return
 	}
 
-	err = controller.UnregistProcess(process)
+	entry, err := controller.UnregistProcess(process)
 	if err != nil {
 		log.Warn("fail to unregist : %s", err.Error())
-		web.WriteSystemSuccess(res, req, "fail to unregist : "+err.Error())
+		web.WriteSystemError(res, req, "fail to unregist : "+err.Error())
 		return
 	}
-	web.WriteSystemSuccess(res, req, "success")
+	web.WriteSystemSuccess(res, req, "success. archived to "+entry.File)
+}
+
+func restoreProcess(controller web.JunoWebServiceController, res http.ResponseWriter, req *http.Request) {
+	/*
+		{"process": "ifbccard"} or {"file": "ifbccard.20261019-194500.tar.gz"}
+		{"system": {"message": "success. restored from ifbccard.20261019-194500.tar.gz", "code": 200}}
+	*/
+	params, err := parsingRequest(req)
+	if err != nil {
+		log.Warn("invalid parameter : %s", err.Error())
+		web.ResponseError(res, req, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	clientAddress, _ := params["client_address"]
+	if !controller.IsRemoteOperationAllowed(clientAddress) {
+		log.Warn("remote operation is not allowed")
+		web.ResponseError(res, req, http.StatusForbidden, "remote operation is not allowed")
+		return
+	}
+
+	name, ok := params["file"]
+	if !ok {
+		name, ok = params["process"]
+	}
+	if !ok || len(name) == 0 {
+		web.ResponseError(res, req, http.StatusBadRequest, "invalid parameter : not found process or file")
+		return
+	}
+
+	entry, err := controller.RestoreProcess(name)
+	if err != nil {
+		log.Warn("fail to restore : %s", err.Error())
+		web.WriteSystemError(res, req, "fail to restore : "+err.Error())
+		return
+	}
+	web.WriteSystemSuccess(res, req, "success. restored from "+entry.File)
+}
+
+func listTrash(controller web.JunoWebServiceController, res http.ResponseWriter, req *http.Request) {
+	/*
+		{"trash": [{"file": "ifbccard.20261019-194500.tar.gz", "process": "ifbccard", "group": "svc", "size": 10240, "created": 1760870700000, "expire": 1763462700000}]}
+	*/
+	report := make(map[string]interface{})
+	report["trash"] = controller.ListTrash()
+	b, err := json.Marshal(report)
+	if err != nil {
+		log.Warn("fail to build json response : %s", err.Error())
+		web.ResponseError(res, req, http.StatusInternalServerError, err.Error())
+		return
+	}
+	web.ResponseSuccess(res, req, string(b))
 }
 
 func clearIcProcess(controller web.JunoWebServiceController, res http.ResponseWriter, req *http.Request) {
@@ -236,3 +296,609 @@ func deploymentHistoryProcess(controller web.JunoWebServiceController, res http.
 	}
 	web.ResponseSuccess(res, req, string(b))
 }
+
+func displayLaunchSpec(controller web.JunoWebServiceController, res http.ResponseWriter, req *http.Request) {
+	/*
+		{"process": "ifbccard"}
+		{"process": "ifbccard", "launch": {"args": ["-c", "conf.yaml"], "env": {"TZ": "Asia/Seoul"}}}
+	*/
+	params, err := parsingRequest(req)
+	if err != nil {
+		log.Warn("invalid parameter : %s", err.Error())
+		web.ResponseError(res, req, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	process, ok := params["process"]
+	if !ok {
+		web.ResponseError(res, req, http.StatusBadRequest, "invalid parameter : not found process")
+		return
+	}
+
+	spec, err := controller.GetLaunchSpec(process)
+	if err != nil {
+		web.WriteSystemError(res, req, err.Error())
+		return
+	}
+
+	report := make(map[string]interface{})
+	report["process"] = process
+	report["launch"] = spec
+	b, err := json.Marshal(report)
+	if err != nil {
+		log.Warn("fail to build json response : %s", err.Error())
+		web.ResponseError(res, req, http.StatusInternalServerError, err.Error())
+		return
+	}
+	web.ResponseSuccess(res, req, string(b))
+}
+
+type launchSpecRequest struct {
+	Process       string            `json:"process"`
+	ClientAddress string            `json:"client_address"`
+	Launch        domain.LaunchSpec `json:"launch"`
+}
+
+func changeLaunchSpec(controller web.JunoWebServiceController, res http.ResponseWriter, req *http.Request) {
+	/*
+		{"process": "ifbccard", "launch": {"args": ["-c", "conf.yaml"], "env": {"TZ": "Asia/Seoul"}, "working_dir": "bin", "umask": "022"}}
+		{"system": {"message": "success", "code": 200}}
+	*/
+	b, err := io.ReadAll(req.Body)
+	if err != nil {
+		log.Warn("fail to read request data : %s", err.Error())
+		web.ResponseError(res, req, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	params := launchSpecRequest{}
+	err = json.Unmarshal(b, &params)
+	if err != nil {
+		log.Warn("invalid read request data : %s", err.Error())
+		web.ResponseError(res, req, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if !controller.IsRemoteOperationAllowed(params.ClientAddress) {
+		log.Warn("remote operation is not allowed")
+		web.ResponseError(res, req, http.StatusForbidden, "remote operation is not allowed")
+		return
+	}
+
+	if len(params.Process) == 0 {
+		web.ResponseError(res, req, http.StatusBadRequest, "invalid parameter : not found process")
+		return
+	}
+
+	err = controller.UpdateLaunchSpec(params.Process, params.Launch)
+	if err != nil {
+		log.Warn("fail to change launch spec : %s", err.Error())
+		web.WriteSystemError(res, req, "fail to change launch spec : "+err.Error())
+		return
+	}
+	web.WriteSystemSuccess(res, req, "success")
+}
+
+func displayRestartPolicy(controller web.JunoWebServiceController, res http.ResponseWriter, req *http.Request) {
+	/*
+		{"process": "ifbccard"}
+		{"process": "ifbccard", "custom": true, "restart": {"mode": "on-failure", "max_retry": 5, "window_sec": 600, "backoff_initial_ms": 1000, "backoff_max_ms": 60000, "backoff_jitter": 0.2, "stable_sec": 300}}
+	*/
+	params, err := parsingRequest(req)
+	if err != nil {
+		log.Warn("invalid parameter : %s", err.Error())
+		web.ResponseError(res, req, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	process, ok := params["process"]
+	if !ok {
+		web.ResponseError(res, req, http.StatusBadRequest, "invalid parameter : not found process")
+		return
+	}
+
+	policy, custom, err := controller.GetRestartPolicy(process)
+	if err != nil {
+		web.WriteSystemError(res, req, err.Error())
+		return
+	}
+
+	report := make(map[string]interface{})
+	report["process"] = process
+	report["custom"] = custom
+	report["restart"] = policy
+	b, err := json.Marshal(report)
+	if err != nil {
+		log.Warn("fail to build json response : %s", err.Error())
+		web.ResponseError(res, req, http.StatusInternalServerError, err.Error())
+		return
+	}
+	web.ResponseSuccess(res, req, string(b))
+}
+
+type restartPolicyRequest struct {
+	Process       string                `json:"process"`
+	ClientAddress string                `json:"client_address"`
+	Restart       *domain.RestartPolicy `json:"restart"`
+}
+
+func changeRestartPolicy(controller web.JunoWebServiceController, res http.ResponseWriter, req *http.Request) {
+	/*
+		{"process": "ifbccard", "restart": {"mode": "on-failure", "max_retry": 5, "window_sec": 600, "backoff_initial_ms": 1000, "backoff_max_ms": 60000, "backoff_jitter": 0.2, "stable_sec": 300}}
+		{"process": "ifbccard"} : 프로세스별 정책을 삭제하고 기본 정책을 따른다
+		{"system": {"message": "success", "code": 200}}
+	*/
+	b, err := io.ReadAll(req.Body)
+	if err != nil {
+		log.Warn("fail to read request data : %s", err.Error())
+		web.ResponseError(res, req, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	params := restartPolicyRequest{}
+	err = json.Unmarshal(b, &params)
+	if err != nil {
+		log.Warn("invalid read request data : %s", err.Error())
+		web.ResponseError(res, req, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if !controller.IsRemoteOperationAllowed(params.ClientAddress) {
+		log.Warn("remote operation is not allowed")
+		web.ResponseError(res, req, http.StatusForbidden, "remote operation is not allowed")
+		return
+	}
+
+	if len(params.Process) == 0 {
+		web.ResponseError(res, req, http.StatusBadRequest, "invalid parameter : not found process")
+		return
+	}
+
+	err = controller.UpdateRestartPolicy(params.Process, params.Restart)
+	if err != nil {
+		log.Warn("fail to change restart policy : %s", err.Error())
+		web.WriteSystemError(res, req, "fail to change restart policy : "+err.Error())
+		return
+	}
+	web.WriteSystemSuccess(res, req, "success")
+}
+
+func displayThresholdConfig(controller web.JunoWebServiceController, res http.ResponseWriter, req *http.Request) {
+	/*
+		{"process": "ifbccard"}
+		{"process": "ifbccard", "custom": true, "threshold": {"rules": [{"metric": "rss", "value": 2048, "for_sec": 300, "action": "restart"}, {"metric": "cpu", "value": 90, "for_sec": 600, "level": "major", "action": "dump"}]}}
+	*/
+	params, err := parsingRequest(req)
+	if err != nil {
+		log.Warn("invalid parameter : %s", err.Error())
+		web.ResponseError(res, req, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	process, ok := params["process"]
+	if !ok {
+		web.ResponseError(res, req, http.StatusBadRequest, "invalid parameter : not found process")
+		return
+	}
+
+	config, custom, err := controller.GetThresholdConfig(process)
+	if err != nil {
+		web.WriteSystemError(res, req, err.Error())
+		return
+	}
+
+	report := make(map[string]interface{})
+	report["process"] = process
+	report["custom"] = custom
+	report["threshold"] = config
+	b, err := json.Marshal(report)
+	if err != nil {
+		log.Warn("fail to build json response : %s", err.Error())
+		web.ResponseError(res, req, http.StatusInternalServerError, err.Error())
+		return
+	}
+	web.ResponseSuccess(res, req, string(b))
+}
+
+type thresholdRequest struct {
+	Process       string                  `json:"process"`
+	ClientAddress string                  `json:"client_address"`
+	Threshold     *domain.ThresholdConfig `json:"threshold"`
+}
+
+func changeThresholdConfig(controller web.JunoWebServiceController, res http.ResponseWriter, req *http.Request) {
+	/*
+		{"process": "ifbccard", "threshold": {"rules": [{"metric": "fd_percent", "value": 80, "for_sec": 60}]}}
+		{"process": "ifbccard", "threshold": {"rules": []}} : 임계치 알람을 사용하지 않는다
+		{"process": "ifbccard"} : 프로세스별 규칙을 삭제하고 기본 규칙을 따른다
+		{"system": {"message": "success", "code": 200}}
+	*/
+	b, err := io.ReadAll(req.Body)
+	if err != nil {
+		log.Warn("fail to read request data : %s", err.Error())
+		web.ResponseError(res, req, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	params := thresholdRequest{}
+	err = json.Unmarshal(b, &params)
+	if err != nil {
+		log.Warn("invalid read request data : %s", err.Error())
+		web.ResponseError(res, req, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if !controller.IsRemoteOperationAllowed(params.ClientAddress) {
+		log.Warn("remote operation is not allowed")
+		web.ResponseError(res, req, http.StatusForbidden, "remote operation is not allowed")
+		return
+	}
+
+	if len(params.Process) == 0 {
+		web.ResponseError(res, req, http.StatusBadRequest, "invalid parameter : not found process")
+		return
+	}
+
+	err = controller.UpdateThresholdConfig(params.Process, params.Threshold)
+	if err != nil {
+		log.Warn("fail to change threshold : %s", err.Error())
+		web.WriteSystemError(res, req, "fail to change threshold : "+err.Error())
+		return
+	}
+	web.WriteSystemSuccess(res, req, "success")
+}
+
+func pauseProcess(controller web.JunoWebServiceController, res http.ResponseWriter, req *http.Request) {
+	/*
+		{"process": "ifbccard"}
+		{"package_group": "basic", "package_host": "xfp-dev", "summary": {"message": "PROCESS : ifbccard\nPAUSED 1234\n", "package_name": "default"}}
+	*/
+	handleSignalRequest(controller, res, req, func(params map[string]string) map[string]interface{} {
+		return controller.PauseProcess(params["process"])
+	})
+}
+
+func resumeProcess(controller web.JunoWebServiceController, res http.ResponseWriter, req *http.Request) {
+	/*
+		{"process": "ifbccard"}
+		{"package_group": "basic", "package_host": "xfp-dev", "summary": {"message": "PROCESS : ifbccard\nRESUMED 1234\n", "package_name": "default"}}
+	*/
+	handleSignalRequest(controller, res, req, func(params map[string]string) map[string]interface{} {
+		return controller.ResumeProcess(params["process"])
+	})
+}
+
+func signalProcess(controller web.JunoWebServiceController, res http.ResponseWriter, req *http.Request) {
+	/*
+		{"process": "ifbccard", "signal": "HUP"}
+		{"package_group": "basic", "package_host": "xfp-dev", "summary": {"message": "PROCESS : ifbccard\nSENT HANGUP TO 1234\n", "package_name": "default"}}
+	*/
+	handleSignalRequest(controller, res, req, func(params map[string]string) map[string]interface{} {
+		signal, ok := params["signal"]
+		if !ok {
+			report := make(map[string]interface{})
+			report["system"] = web.SystemResponse{Code: 700, Message: "not found signal"}
+			return report
+		}
+		return controller.SignalProcess(params["process"], signal)
+	})
+}
+
+func handleSignalRequest(controller web.JunoWebServiceController, res http.ResponseWriter, req *http.Request,
+	execute func(map[string]string) map[string]interface{}) {
+	params, err := parsingRequest(req)
+	if err != nil {
+		log.Warn("invalid parameter : %s", err.Error())
+		web.ResponseError(res, req, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	clientAddress, _ := params["client_address"]
+	if !controller.IsRemoteOperationAllowed(clientAddress) {
+		log.Warn("remote operation is not allowed")
+		web.ResponseError(res, req, http.StatusForbidden, "remote operation is not allowed")
+		return
+	}
+
+	if _, ok := params["process"]; !ok {
+		web.ResponseError(res, req, http.StatusBadRequest, "invalid parameter : not found process")
+		return
+	}
+
+	report := execute(params)
+	b, err := json.Marshal(report)
+	if err != nil {
+		log.Warn("fail to build json response : %s", err.Error())
+		web.ResponseError(res, req, http.StatusInternalServerError, err.Error())
+		return
+	}
+	web.ResponseSuccess(res, req, string(b))
+}
+
+// parseMaintenanceTarget process 혹은 group 파라미터로 점검 대상을 결정한다
+func parseMaintenanceTarget(params map[string]string) (string, string, bool) {
+	if process, ok := params["process"]; ok && len(process) > 0 {
+		return domain.MaintenanceTargetProcess, process, true
+	}
+	if group, ok := params["group"]; ok && len(group) > 0 {
+		return domain.MaintenanceTargetGroup, group, true
+	}
+	return "", "", false
+}
+
+func setMaintenance(controller web.JunoWebServiceController, res http.ResponseWriter, req *http.Request) {
+	/*
+		{"process": "ifbccard", "duration": "2h", "reason": "db migration"}
+		{"group": "svc", "duration": "30m"}
+		{"system": {"message": "success", "code": 200}}
+	*/
+	params, err := parsingRequest(req)
+	if err != nil {
+		log.Warn("invalid parameter : %s", err.Error())
+		web.ResponseError(res, req, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	clientAddress, _ := params["client_address"]
+	if !controller.IsRemoteOperationAllowed(clientAddress) {
+		log.Warn("remote operation is not allowed")
+		web.ResponseError(res, req, http.StatusForbidden, "remote operation is not allowed")
+		return
+	}
+
+	targetType, target, ok := parseMaintenanceTarget(params)
+	if !ok {
+		web.ResponseError(res, req, http.StatusBadRequest, "invalid parameter : not found process or group")
+		return
+	}
+	duration, ok := params["duration"]
+	if !ok {
+		web.ResponseError(res, req, http.StatusBadRequest, "invalid parameter : not found duration")
+		return
+	}
+
+	err = controller.SetMaintenance(targetType, target, duration, params["reason"])
+	if err != nil {
+		log.Warn("fail to set maintenance : %s", err.Error())
+		web.WriteSystemError(res, req, "fail to set maintenance : "+err.Error())
+		return
+	}
+	web.WriteSystemSuccess(res, req, "success")
+}
+
+func clearMaintenance(controller web.JunoWebServiceController, res http.ResponseWriter, req *http.Request) {
+	/*
+		{"process": "ifbccard"}
+		{"system": {"message": "success", "code": 200}}
+	*/
+	params, err := parsingRequest(req)
+	if err != nil {
+		log.Warn("invalid parameter : %s", err.Error())
+		web.ResponseError(res, req, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	clientAddress, _ := params["client_address"]
+	if !controller.IsRemoteOperationAllowed(clientAddress) {
+		log.Warn("remote operation is not allowed")
+		web.ResponseError(res, req, http.StatusForbidden, "remote operation is not allowed")
+		return
+	}
+
+	targetType, target, ok := parseMaintenanceTarget(params)
+	if !ok {
+		web.ResponseError(res, req, http.StatusBadRequest, "invalid parameter : not found process or group")
+		return
+	}
+
+	err = controller.ClearMaintenance(targetType, target)
+	if err != nil {
+		log.Warn("fail to clear maintenance : %s", err.Error())
+		web.WriteSystemError(res, req, "fail to clear maintenance : "+err.Error())
+		return
+	}
+	web.WriteSystemSuccess(res, req, "success")
+}
+
+func listMaintenance(controller web.JunoWebServiceController, res http.ResponseWriter, req *http.Request) {
+	/*
+		{"maintenance": [{"target_type": "process", "target": "ifbccard", "until": 1760000000000, "reason": "db migration", "created": 1759990000000}]}
+	*/
+	report := make(map[string]interface{})
+	report["maintenance"] = controller.ListMaintenance()
+	b, err := json.Marshal(report)
+	if err != nil {
+		log.Warn("fail to build json response : %s", err.Error())
+		web.ResponseError(res, req, http.StatusInternalServerError, err.Error())
+		return
+	}
+	web.ResponseSuccess(res, req, string(b))
+}
+
+func planProcess(controller web.JunoWebServiceController, res http.ResponseWriter, req *http.Request) {
+	/*
+		{"action": "stop", "all": "true"}
+		{"plan": {"action": "stop", "stages": [{"order": 1, "action": "stop", "weight": 0, "processes": [{"name": "ifbccard", "group": "svc", "pid": 1234, "goaway": ["ipc"]}], "wait_sec": 33}], "skipped": [], "expected_sec": 33}}
+	*/
+	params, err := parsingRequest(req)
+	if err != nil {
+		log.Warn("invalid parameter : %s", err.Error())
+		web.ResponseError(res, req, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	action, ok := params["action"]
+	if !ok {
+		web.ResponseError(res, req, http.StatusBadRequest, "invalid parameter : not found action")
+		return
+	}
+	_, all := params["all"]
+	group := params["group"]
+	process := params["process"]
+
+	plan, err := controller.PlanOperation(action, all, group, process)
+	if err != nil {
+		web.WriteSystemError(res, req, err.Error())
+		return
+	}
+
+	report := make(map[string]interface{})
+	report["plan"] = plan
+	b, err := json.Marshal(report)
+	if err != nil {
+		log.Warn("fail to build json response : %s", err.Error())
+		web.ResponseError(res, req, http.StatusInternalServerError, err.Error())
+		return
+	}
+	web.ResponseSuccess(res, req, string(b))
+}
+
+func displayProcessExits(controller web.JunoWebServiceController, res http.ResponseWriter, req *http.Request) {
+	/*
+		{"process": "ifbccard"}
+		{"process": "ifbccard", "exits": [{"process": "ifbccard", "pid": 1234, "time": 1760886000000, "exit_code": -1, "signal": "SIGSEGV", "core_dumped": true, "user_cpu_ms": 1520, "sys_cpu_ms": 230, "max_rss_kb": 81234}]}
+	*/
+	params, err := parsingRequest(req)
+	if err != nil {
+		log.Warn("invalid parameter : %s", err.Error())
+		web.ResponseError(res, req, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	process, ok := params["process"]
+	if !ok {
+		web.ResponseError(res, req, http.StatusBadRequest, "invalid parameter : not found process")
+		return
+	}
+
+	report := make(map[string]interface{})
+	report["process"] = process
+	report["exits"] = controller.ListProcessExits(process)
+	b, err := json.Marshal(report)
+	if err != nil {
+		log.Warn("fail to build json response : %s", err.Error())
+		web.ResponseError(res, req, http.StatusInternalServerError, err.Error())
+		return
+	}
+	web.ResponseSuccess(res, req, string(b))
+}
+
+func displayMetricSeries(controller web.JunoWebServiceController, res http.ResponseWriter, req *http.Request) {
+	/*
+		{"process": "searchd", "metric": "rss", "from": "1760886000000", "to": "1760889600000"}
+		{"process": "searchd", "metric": "rss", "step_sec": 10, "from": 1760886000000, "to": 1760889600000, "points": [{"time": 1760886000000, "avg": 81234, "max": 81240}]}
+		metric : cpu(%), rss(KB), fd, thread
+		from, to : unix millis. 생략하면 최근 1시간
+		step_sec : point 간격. 최근 1시간은 monitor scan 주기(monitor.poll.sec)를 따른다
+	*/
+	params, err := parsingRequest(req)
+	if err != nil {
+		log.Warn("invalid parameter : %s", err.Error())
+		web.ResponseError(res, req, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	process, ok := params["process"]
+	if !ok {
+		web.ResponseError(res, req, http.StatusBadRequest, "invalid parameter : not found process")
+		return
+	}
+
+	metric, ok := params["metric"]
+	if !ok || !domain.IsValidMetric(metric) {
+		web.ResponseError(res, req, http.StatusBadRequest, "invalid parameter : metric must be one of cpu, rss, fd, thread")
+		return
+	}
+
+	var from, to int64
+	if v, ok := params["from"]; ok {
+		from, err = strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			web.ResponseError(res, req, http.StatusBadRequest, "invalid parameter : from")
+			return
+		}
+	}
+	if v, ok := params["to"]; ok {
+		to, err = strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			web.ResponseError(res, req, http.StatusBadRequest, "invalid parameter : to")
+			return
+		}
+	}
+
+	series, err := controller.GetMetricSeries(process, metric, from, to)
+	if err != nil {
+		web.WriteSystemError(res, req, err.Error())
+		return
+	}
+
+	b, err := json.Marshal(series)
+	if err != nil {
+		log.Warn("fail to build json response : %s", err.Error())
+		web.ResponseError(res, req, http.StatusInternalServerError, err.Error())
+		return
+	}
+	web.ResponseSuccess(res, req, string(b))
+}
+
+func displayProcessEvents(controller web.JunoWebServiceController, res http.ResponseWriter, req *http.Request) {
+	/*
+		{"process": "searchd", "event": "crashed", "from": "1760886000000", "to": "1760889600000", "limit": "50"}
+		{"process": "searchd", "events": [{"time": 1760886000000, "process": "searchd", "event": "crashed", "pid": 1234, "actor": "monitor", "reason": "status changed from ALIVE", "exit": {"process": "searchd", "pid": 1234, "time": 1760886000000, "exit_code": -1, "signal": "SIGSEGV", "user_cpu_ms": 1520, "sys_cpu_ms": 230, "max_rss_kb": 81234}}]}
+		event : started, stopped, crashed, restarted, hung, deploy_activated. 생략하면 전체
+		from, to : unix millis. limit : 생략하면 100
+	*/
+	params, err := parsingRequest(req)
+	if err != nil {
+		log.Warn("invalid parameter : %s", err.Error())
+		web.ResponseError(res, req, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	process, ok := params["process"]
+	if !ok {
+		web.ResponseError(res, req, http.StatusBadRequest, "invalid parameter : not found process")
+		return
+	}
+
+	var from, to int64
+	if v, ok := params["from"]; ok {
+		from, err = strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			web.ResponseError(res, req, http.StatusBadRequest, "invalid parameter : from")
+			return
+		}
+	}
+	if v, ok := params["to"]; ok {
+		to, err = strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			web.ResponseError(res, req, http.StatusBadRequest, "invalid parameter : to")
+			return
+		}
+	}
+	limit := 100
+	if v, ok := params["limit"]; ok {
+		limit, err = strconv.Atoi(v)
+		if err != nil || limit < 1 {
+			web.ResponseError(res, req, http.StatusBadRequest, "invalid parameter : limit")
+			return
+		}
+	}
+
+	events, err := controller.ListProcessEvents(process, from, to, params["event"], limit)
+	if err != nil {
+		web.WriteSystemError(res, req, err.Error())
+		return
+	}
+
+	report := make(map[string]interface{})
+	report["process"] = process
+	report["events"] = events
+	b, err := json.Marshal(report)
+	if err != nil {
+		log.Warn("fail to build json response : %s", err.Error())
+		web.ResponseError(res, req, http.StatusInternalServerError, err.Error())
+		return
+	}
+	web.ResponseSuccess(res, req, string(b))
+}
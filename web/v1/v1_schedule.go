@@ -0,0 +1,128 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 5:40
+ */
+
+package v1
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/fatima-go/fatima-log"
+	"github.com/fatima-go/juno/domain"
+	"github.com/fatima-go/juno/web"
+)
+
+type scheduleRequest struct {
+	domain.ScheduleRequest
+	ClientAddress string `json:"client_address"`
+}
+
+func scheduleOperation(controller web.JunoWebServiceController, res http.ResponseWriter, req *http.Request) {
+	/*
+		{"action": "restart", "process": "batchd", "execute_at": "2026-10-20 03:00:00", "comment": "weekly restart"}
+		{"action": "loglevel", "process": "batchd", "loglevel": "debug", "execute_at": "2026-10-20 03:00:00"}
+		{"schedule": {"id": "...", "action": "restart", "process": "batchd", "execute_at": 1760896800000, "status": "PENDING", ...}}
+	*/
+	b, err := io.ReadAll(req.Body)
+	if err != nil {
+		log.Warn("fail to read request data : %s", err.Error())
+		web.ResponseError(res, req, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	params := scheduleRequest{}
+	err = json.Unmarshal(b, &params)
+	if err != nil {
+		log.Warn("invalid read request data : %s", err.Error())
+		web.ResponseError(res, req, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if !controller.IsRemoteOperationAllowed(params.ClientAddress) {
+		log.Warn("remote operation is not allowed")
+		web.ResponseError(res, req, http.StatusForbidden, "remote operation is not allowed")
+		return
+	}
+
+	op, err := controller.ScheduleOperation(web.GetFatimaClientTimezone(req), params.ScheduleRequest)
+	if err != nil {
+		log.Warn("fail to schedule operation : %s", err.Error())
+		web.WriteSystemError(res, req, "fail to schedule operation : "+err.Error())
+		return
+	}
+
+	report := make(map[string]interface{})
+	report["schedule"] = op
+	b, err = json.Marshal(report)
+	if err != nil {
+		log.Warn("fail to build json response : %s", err.Error())
+		web.ResponseError(res, req, http.StatusInternalServerError, err.Error())
+		return
+	}
+	web.ResponseSuccess(res, req, string(b))
+}
+
+func listScheduledOperation(controller web.JunoWebServiceController, res http.ResponseWriter, req *http.Request) {
+	/*
+		{"pending": [...], "history": [...]}
+	*/
+	b, err := json.Marshal(controller.ListScheduledOperation())
+	if err != nil {
+		log.Warn("fail to build json response : %s", err.Error())
+		web.ResponseError(res, req, http.StatusInternalServerError, err.Error())
+		return
+	}
+	web.ResponseSuccess(res, req, string(b))
+}
+
+func cancelScheduledOperation(controller web.JunoWebServiceController, res http.ResponseWriter, req *http.Request) {
+	/*
+		{"id": "..."}
+		{"system": {"message": "success", "code": 200}}
+	*/
+	params, err := parsingRequest(req)
+	if err != nil {
+		log.Warn("invalid parameter : %s", err.Error())
+		web.ResponseError(res, req, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	clientAddress, _ := params["client_address"]
+	if !controller.IsRemoteOperationAllowed(clientAddress) {
+		log.Warn("remote operation is not allowed")
+		web.ResponseError(res, req, http.StatusForbidden, "remote operation is not allowed")
+		return
+	}
+
+	id, ok := params["id"]
+	if !ok {
+		web.ResponseError(res, req, http.StatusBadRequest, "invalid parameter : not found id")
+		return
+	}
+
+	err = controller.CancelScheduledOperation(id)
+	if err != nil {
+		log.Warn("fail to cancel scheduled operation : %s", err.Error())
+		web.WriteSystemError(res, req, "fail to cancel : "+err.Error())
+		return
+	}
+	web.WriteSystemSuccess(res, req, "success")
+}
@@ -0,0 +1,157 @@
+/*
+ * Copyright 2026 github.com/fatima-go
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * @project juno
+ * @author agent
+ * @date 26. 10. 19. 오전 5:51
+ */
+
+package v1
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/fatima-go/fatima-log"
+	"github.com/fatima-go/juno/domain"
+	"github.com/fatima-go/juno/web"
+)
+
+const (
+	defaultScriptHistoryLimit = 50
+	// 스크립트 실행이 끝난 뒤 응답을 쓰는 제한 시간
+	scriptResponseWriteTimeout = 15 * time.Second
+)
+
+type scriptRunRequest struct {
+	domain.ScriptRunRequest
+	ClientAddress string `json:"client_address"`
+}
+
+func listScripts(controller web.JunoWebServiceController, res http.ResponseWriter, req *http.Request) {
+	/*
+		{"process": "ifbccard"}
+		{"scripts": [{"name": "flush-cache", "description": "flush local cache", "file": "flush-cache.sh"}]}
+	*/
+	params, err := parsingRequest(req)
+	if err != nil {
+		log.Warn("invalid parameter : %s", err.Error())
+		web.ResponseError(res, req, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	process, ok := params["process"]
+	if !ok {
+		web.ResponseError(res, req, http.StatusBadRequest, "invalid parameter : not found process")
+		return
+	}
+
+	scripts, err := controller.ListScripts(process)
+	if err != nil {
+		web.WriteSystemError(res, req, err.Error())
+		return
+	}
+
+	report := make(map[string]interface{})
+	report["scripts"] = scripts
+	writeScriptResponse(res, req, report)
+}
+
+func runScript(controller web.JunoWebServiceController, res http.ResponseWriter, req *http.Request) {
+	/*
+		{"process": "ifbccard", "command": "flush-cache", "args": ["all"], "timeout_sec": 30}
+		{"result": {"process": "ifbccard", "command": "flush-cache", "exit_code": 0, "duration_ms": 120, "stdout": "...", "stderr": ""}}
+	*/
+	b, err := io.ReadAll(req.Body)
+	if err != nil {
+		log.Warn("fail to read request data : %s", err.Error())
+		web.ResponseError(res, req, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	params := scriptRunRequest{}
+	err = json.Unmarshal(b, &params)
+	if err != nil {
+		log.Warn("invalid read request data : %s", err.Error())
+		web.ResponseError(res, req, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if !controller.IsRemoteOperationAllowed(params.ClientAddress) {
+		log.Warn("remote operation is not allowed")
+		web.ResponseError(res, req, http.StatusForbidden, "remote operation is not allowed")
+		return
+	}
+
+	client := params.ClientAddress
+	if len(client) == 0 {
+		client = req.RemoteAddr
+	}
+	result, err := controller.RunScript(params.ScriptRunRequest, client)
+
+	// 스크립트는 자체 제한 시간(최대 script.timeout.max.sec)까지 실행되므로
+	// server 의 WriteTimeout 이 아닌 실행 종료 시점부터 응답 쓰기 제한 시간을 다시 적용한다
+	if e := http.NewResponseController(res).SetWriteDeadline(time.Now().Add(scriptResponseWriteTimeout)); e != nil {
+		log.Warn("fail to extend write deadline : %s", e.Error())
+	}
+	if err != nil {
+		log.Warn("fail to run script : %s", err.Error())
+		web.WriteSystemError(res, req, "fail to run script : "+err.Error())
+		return
+	}
+
+	report := make(map[string]interface{})
+	report["result"] = result
+	writeScriptResponse(res, req, report)
+}
+
+func scriptHistory(controller web.JunoWebServiceController, res http.ResponseWriter, req *http.Request) {
+	/*
+		{"process": "ifbccard", "limit": "20"}
+		{"history": [{"process": "ifbccard", "command": "flush-cache", "client": "10.0.0.1", "started": 1760870700000, "exit_code": 0, ...}]}
+	*/
+	params, err := parsingRequest(req)
+	if err != nil {
+		log.Warn("invalid parameter : %s", err.Error())
+		web.ResponseError(res, req, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	limit := defaultScriptHistoryLimit
+	if v, ok := params["limit"]; ok {
+		limit, err = strconv.Atoi(v)
+		if err != nil || limit < 1 {
+			web.ResponseError(res, req, http.StatusBadRequest, "invalid parameter : limit")
+			return
+		}
+	}
+
+	report := make(map[string]interface{})
+	report["history"] = controller.ListScriptHistory(params["process"], limit)
+	writeScriptResponse(res, req, report)
+}
+
+func writeScriptResponse(res http.ResponseWriter, req *http.Request, report map[string]interface{}) {
+	b, err := json.Marshal(report)
+	if err != nil {
+		log.Warn("fail to build json response : %s", err.Error())
+		web.ResponseError(res, req, http.StatusInternalServerError, err.Error())
+		return
+	}
+	web.ResponseSuccess(res, req, string(b))
+}
@@ -82,10 +82,77 @@ func (version1 *Version1Handler) HandleProcess(method string, res http.ResponseW
 		version1.secureHandle(domain.ROLE_OPERATOR, res, req, registProcess)
 	case "unregist":
 		version1.secureHandle(domain.ROLE_OPERATOR, res, req, unregistProcess)
+	case "restore":
+		version1.secureHandle(domain.ROLE_OPERATOR, res, req, restoreProcess)
+	case "trashlist":
+		version1.secureHandle(domain.ROLE_MONITOR, res, req, listTrash)
 	case "clric":
 		version1.secureHandle(domain.ROLE_OPERATOR, res, req, clearIcProcess)
 	case "history":
 		version1.secureHandle(domain.ROLE_MONITOR, res, req, deploymentHistoryProcess)
+	case "launch":
+		// env 값에 secret 이 포함될 수 있으므로 OPERATOR 만 조회할 수 있다. 키 목록은 process report 에서 확인한다
+		version1.secureHandle(domain.ROLE_OPERATOR, res, req, displayLaunchSpec)
+	case "chglaunch":
+		version1.secureHandle(domain.ROLE_OPERATOR, res, req, changeLaunchSpec)
+	case "pause":
+		version1.secureHandle(domain.ROLE_OPERATOR, res, req, pauseProcess)
+	case "resume":
+		version1.secureHandle(domain.ROLE_OPERATOR, res, req, resumeProcess)
+	case "signal":
+		version1.secureHandle(domain.ROLE_OPERATOR, res, req, signalProcess)
+	case "maint":
+		version1.secureHandle(domain.ROLE_OPERATOR, res, req, setMaintenance)
+	case "unmaint":
+		version1.secureHandle(domain.ROLE_OPERATOR, res, req, clearMaintenance)
+	case "maintlist":
+		version1.secureHandle(domain.ROLE_MONITOR, res, req, listMaintenance)
+	case "plan":
+		version1.secureHandle(domain.ROLE_MONITOR, res, req, planProcess)
+	case "schedule":
+		version1.secureHandle(domain.ROLE_OPERATOR, res, req, scheduleOperation)
+	case "schedlist":
+		version1.secureHandle(domain.ROLE_MONITOR, res, req, listScheduledOperation)
+	case "schedcancel":
+		version1.secureHandle(domain.ROLE_OPERATOR, res, req, cancelScheduledOperation)
+	case "def":
+		version1.secureHandle(domain.ROLE_MONITOR, res, req, displayDefinition)
+	case "chgdef":
+		version1.secureHandle(domain.ROLE_OPERATOR, res, req, changeProcessDefinition)
+	case "chggroup":
+		version1.secureHandle(domain.ROLE_OPERATOR, res, req, changeGroupDefinition)
+	case "scripts":
+		version1.secureHandle(domain.ROLE_MONITOR, res, req, listScripts)
+	case "runscript":
+		version1.secureHandle(domain.ROLE_OPERATOR, res, req, runScript)
+	case "scripthist":
+		version1.secureHandle(domain.ROLE_MONITOR, res, req, scriptHistory)
+	case "dump":
+		version1.secureHandle(domain.ROLE_OPERATOR, res, req, dumpProcess)
+	case "dumplist":
+		version1.secureHandle(domain.ROLE_MONITOR, res, req, listDumps)
+	case "dumpget":
+		version1.secureHandle(domain.ROLE_MONITOR, res, req, getDump)
+	case "restartpolicy":
+		version1.secureHandle(domain.ROLE_MONITOR, res, req, displayRestartPolicy)
+	case "chgrestart":
+		version1.secureHandle(domain.ROLE_OPERATOR, res, req, changeRestartPolicy)
+	case "exits":
+		version1.secureHandle(domain.ROLE_MONITOR, res, req, displayProcessExits)
+	case "threshold":
+		version1.secureHandle(domain.ROLE_MONITOR, res, req, displayThresholdConfig)
+	case "chgthreshold":
+		version1.secureHandle(domain.ROLE_OPERATOR, res, req, changeThresholdConfig)
+	case "metrics":
+		version1.secureHandle(domain.ROLE_MONITOR, res, req, displayMetricSeries)
+	case "events":
+		version1.secureHandle(domain.ROLE_MONITOR, res, req, displayProcessEvents)
+	case "alarmconfig":
+		version1.secureHandle(domain.ROLE_MONITOR, res, req, displayAlarmConfig)
+	case "chgalarmconfig":
+		version1.secureHandle(domain.ROLE_OPERATOR, res, req, changeAlarmConfig)
+	case "testalarm":
+		version1.secureHandle(domain.ROLE_OPERATOR, res, req, sendTestAlarm)
 	default:
 		web.ResponseError(res, req, http.StatusNotFound, "")
 		return
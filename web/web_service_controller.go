@@ -34,8 +34,10 @@ type JunoWebServiceController interface {
 	GetPackageReportForHealthCheck() map[string]string
 	GetLogLevels() domain.LogLevels
 	ChangeLogLevel(proc string, loglevel string) map[string]interface{}
-	RegistProcess(proc string, groupId string) error
-	UnregistProcess(proc string) error
+	RegistProcess(reg domain.ProcessRegistration) error
+	UnregistProcess(proc string) (domain.TrashEntry, error)
+	RestoreProcess(name string) (domain.TrashEntry, error)
+	ListTrash() []domain.TrashEntry
 	GetClipboard() string
 	StopProcess(all bool, group string, proc string) map[string]interface{}
 	StartProcess(all bool, group string, proc string) map[string]interface{}
@@ -46,4 +48,35 @@ type JunoWebServiceController interface {
 	ClearIcProcess(all bool, group string, proc string) map[string]interface{}
 	DeploymentHistory(all bool, group string, proc string) map[string]interface{}
 	GetProcessReport(loc *time.Location, proc string) domain.ProcessReport
+	GetLaunchSpec(proc string) (domain.LaunchSpec, error)
+	UpdateLaunchSpec(proc string, spec domain.LaunchSpec) error
+	PauseProcess(proc string) map[string]interface{}
+	ResumeProcess(proc string) map[string]interface{}
+	SignalProcess(proc string, signal string) map[string]interface{}
+	SetMaintenance(targetType string, target string, duration string, reason string) error
+	ClearMaintenance(targetType string, target string) error
+	ListMaintenance() []domain.Maintenance
+	ScheduleOperation(loc *time.Location, req domain.ScheduleRequest) (domain.ScheduledOperation, error)
+	ListScheduledOperation() map[string]interface{}
+	CancelScheduledOperation(id string) error
+	PlanOperation(action string, all bool, group string, proc string) (domain.OperationPlan, error)
+	GetPackageDefinition() (domain.PackageDefinition, error)
+	UpdateProcessDefinition(update domain.ProcessUpdate) (domain.DefinitionUpdateResult, error)
+	UpdateGroupDefinition(update domain.GroupUpdate) (domain.DefinitionUpdateResult, error)
+	ListScripts(proc string) ([]domain.ScriptCommand, error)
+	RunScript(req domain.ScriptRunRequest, client string) (domain.ScriptRunResult, error)
+	ListScriptHistory(proc string, limit int) []domain.ScriptRunResult
+	DumpProcess(proc string, force bool) (domain.ProcessDump, error)
+	ListDumps(proc string) ([]domain.DumpInfo, error)
+	GetDump(proc string, file string) (domain.ProcessDump, error)
+	GetRestartPolicy(proc string) (domain.RestartPolicy, bool, error)
+	UpdateRestartPolicy(proc string, policy *domain.RestartPolicy) error
+	ListProcessExits(proc string) []domain.ProcessExit
+	GetThresholdConfig(proc string) (domain.ThresholdConfig, bool, error)
+	UpdateThresholdConfig(proc string, config *domain.ThresholdConfig) error
+	GetMetricSeries(proc string, metric string, from int64, to int64) (domain.MetricSeries, error)
+	ListProcessEvents(proc string, from int64, to int64, event string, limit int) ([]domain.ProcessEvent, error)
+	GetAlarmConfig() (domain.AlarmConfig, []domain.AlarmSinkStatus)
+	UpdateAlarmConfig(config *domain.AlarmConfig) error
+	SendTestAlarm(level string, message string) error
 }